@@ -17,13 +17,17 @@
 package v3
 
 import (
+	"strings"
 	"time"
 
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	pgv1 "github.ibm.com/ibm-pg/ibm-pg-types/pkg/api/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
 )
@@ -67,6 +71,55 @@ type ServiceConfig struct {
 	Spec               map[string]ExtensionWithMarker `json:"spec"`
 	ManagementStrategy string                         `json:"managementStrategy,omitempty"`
 	Resources          []ExtensionWithMarker          `json:"resources,omitempty"`
+	// Enabled installs the operator of the service. When it is false, the
+	// operator is no-op in the OperandRegistry and the OperandRequests of the
+	// service are rejected. It is only read from the CommonService CR in the
+	// operator namespace.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// DeleteSubscription uninstalls the operator of a disabled service by
+	// deleting its Subscription and ClusterServiceVersion, the operands are
+	// kept
+	// +optional
+	DeleteSubscription bool `json:"deleteSubscription,omitempty"`
+}
+
+// ScalingFactors are the decimal factors, e.g. "1.5" or "0.5", applied to the
+// size profile
+type ScalingFactors struct {
+	// CPU multiplies the CPU requests and limits
+	CPU string `json:"cpu,omitempty"`
+	// Memory multiplies the memory requests and limits
+	Memory string `json:"memory,omitempty"`
+	// Replicas multiplies the replicas, the result is rounded up
+	Replicas string `json:"replicas,omitempty"`
+	// Target restricts the CPU and memory factors to the requests or the
+	// limits, both are scaled when it is not set
+	// +kubebuilder:validation:Enum=requests;limits
+	// +optional
+	Target string `json:"target,omitempty"`
+}
+
+// SizeFactors defines the factors applied to the resources and replicas of
+// the size profile
+type SizeFactors struct {
+	// The global factors apply to all the services
+	ScalingFactors `json:",inline"`
+	// Services are the factors for a service or one of its components, they
+	// take precedence over the global factors
+	Services []ServiceSizeFactors `json:"services,omitempty"`
+}
+
+// ServiceSizeFactors defines the factors applied to a service of the size profile
+type ServiceSizeFactors struct {
+	// Name is the name of the service in the size profile
+	Name string `json:"name"`
+	// Component is a key of the service spec, e.g. authentication, or the name
+	// of one of the service resources. The factors apply to the whole service
+	// when it is not set
+	// +optional
+	Component      string `json:"component,omitempty"`
+	ScalingFactors `json:",inline"`
 }
 
 // CommonServiceSpec defines the desired state of CommonService
@@ -75,21 +128,42 @@ type CommonServiceSpec struct {
 	// InstallPlanApproval sets the approval mode for ODLM and other
 	// foundational services: Manual or Automatic
 	InstallPlanApproval olmv1alpha1.Approval `json:"installPlanApproval,omitempty"`
-	ManualManagement    bool                 `json:"manualManagement,omitempty"`
+	// InstallPlanApprovalPolicy approves the pending InstallPlans of the
+	// foundational services inside a maintenance window when the
+	// installPlanApproval is Manual. It is only read from the CommonService
+	// CR in the operator namespace.
+	// +optional
+	InstallPlanApprovalPolicy *InstallPlanApprovalPolicy `json:"installPlanApprovalPolicy,omitempty"`
+	ManualManagement          bool                       `json:"manualManagement,omitempty"`
 	// FipsEnabled enables FIPS mode for foundational services
 	FipsEnabled bool `json:"fipsEnabled,omitempty"`
 	// RouteHost describes the hostname for the foundational services route,
 	// and can only be configured pre-installation of IM
 	RouteHost string `json:"routeHost,omitempty"`
 	// Size describes the T-shirt size of foundational services: starterset,
-	// small, medium, or large
+	// small, medium, large, auto, or the name of a user-defined size profile
 	Size string `json:"size,omitempty"`
 	// Services describes the CPU, memory, and replica configuration for
 	// individual services in foundational services
 	Services []ServiceConfig `json:"services,omitempty"`
+	// SizeFactors scales the CPU, memory, and replicas of the size profile,
+	// globally or for individual services
+	// +optional
+	SizeFactors *SizeFactors `json:"sizeFactors,omitempty"`
+	// SizeValidation is the policy for the sizing that is invalid after the
+	// CommonService CRs are merged, e.g. requests larger than limits. AutoFix
+	// corrects the values, Reject keeps the OperandConfig unchanged. It is only
+	// read from the CommonService CR in the operator namespace.
+	// +kubebuilder:validation:Enum=AutoFix;Reject
+	// +optional
+	SizeValidation string `json:"sizeValidation,omitempty"`
 	// StorageClass describes the storage class to use for the foundational
 	// services PVCs
 	StorageClass string `json:"storageClass,omitempty"`
+	// Storage overrides the storage class, the volume size, and the access
+	// modes of the volumes of a service
+	// +optional
+	Storage []ServiceStorage `json:"storage,omitempty"`
 	// BYOCACertificate enables the option to replace the cs-ca-certificate with
 	// your own CA certificate
 	BYOCACertificate bool `json:"BYOCACertificate,omitempty"`
@@ -122,6 +196,42 @@ type CommonServiceSpec struct {
 	// HugePages describes the hugepages settings for foundational services
 	// +kubebuilder:pruning:PreserveUnknownFields
 	HugePages *HugePages `json:"hugepages,omitempty"`
+	// Placement describes the nodes the operands and operators of
+	// foundational services run on
+	// +optional
+	Placement *Placement `json:"placement,omitempty"`
+	// PriorityClassName is the priority class of the operand pods
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// PodDisruptionBudget creates the PodDisruptionBudgets of the operands that
+	// run more than one replica. It is only read from the CommonService CR in
+	// the operator namespace.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// NetworkPolicy creates the NetworkPolicies that allow the traffic of the
+	// services namespace. It is only read from the CommonService CR in the
+	// operator namespace.
+	// +optional
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+	// Proxy is the HTTP proxy of the operators and operands, it is detected
+	// from the cluster Proxy on OpenShift when it is not set
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+	// ImageRegistry rewrites the images of the operands for mirrored
+	// registries. It is only read from the CommonService CR in the operator
+	// namespace.
+	// +optional
+	ImageRegistry *ImageRegistry `json:"imageRegistry,omitempty"`
+	// TLSSecurityProfile is the TLS profile of the operand endpoints, it
+	// mirrors the tlsSecurityProfile of the OpenShift APIServer
+	// +optional
+	TLSSecurityProfile *TLSSecurityProfile `json:"tlsSecurityProfile,omitempty"`
+	// CustomOperators registers more operators in the OperandRegistry and the
+	// OperandConfig of foundational services, so that they are requested by
+	// OperandRequests like the built-in operators. It is only read from the
+	// CommonService CR in the operator namespace.
+	// +optional
+	CustomOperators []CustomOperator `json:"customOperators,omitempty"`
 	// OperatorConfigs is a list of configurations to be applied to operators via CSV updates
 	// +kubebuilder:pruning:PreserveUnknownFields
 	OperatorConfigs []OperatorConfig `json:"operatorConfigs,omitempty"`
@@ -145,6 +255,54 @@ type CommonServiceSpec struct {
 	// IMPORTANT: Only ONE CSPostgreSQLReplica allowed per tenant (first-come-first-serve)
 	// +optional
 	CSPostgreSQLReplica *CSPostgreSQLReplicaConfig `json:"csPostgreSQLReplica,omitempty"`
+
+	// Backup schedules the backups of common-service-db. It is only read from
+	// the CommonService CR in the operator namespace.
+	// +optional
+	Backup *Backup `json:"backup,omitempty"`
+
+	// Restore recovers common-service-db from a backup, optionally to a point
+	// in time. It is only read from the CommonService CR in the operator
+	// namespace.
+	// +optional
+	Restore *Restore `json:"restore,omitempty"`
+}
+
+// InstallPlanApprovalPolicy defines when and which pending InstallPlans are
+// approved
+type InstallPlanApprovalPolicy struct {
+	// MaintenanceWindow is when the pending InstallPlans are approved
+	MaintenanceWindow MaintenanceWindow `json:"maintenanceWindow"`
+	// AllowedVersions are the CSV versions approved for each operator, the
+	// InstallPlans of the operators not in the list are not approved. All
+	// the versions are approved when the list is empty.
+	// +optional
+	AllowedVersions []OperatorVersionRange `json:"allowedVersions,omitempty"`
+	// ApproveTogether approves the pending InstallPlans only when all of
+	// them are allowed, so the operators are upgraded together
+	// +optional
+	ApproveTogether bool `json:"approveTogether,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring window of time
+type MaintenanceWindow struct {
+	// Schedule is the cron schedule of the start of the window, e.g.
+	// "0 2 * * 6" for every Saturday at 02:00
+	Schedule string `json:"schedule"`
+	// Duration is the length of the window, e.g. 4h
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the time zone of the schedule, e.g. America/Toronto, UTC
+	// by default
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// OperatorVersionRange defines the versions of an operator
+type OperatorVersionRange struct {
+	// Name is the name of the operator in its CSV name, e.g. ibm-im-operator
+	Name string `json:"name"`
+	// Versions is the range of the CSV versions, e.g. ">=4.10.0 <4.12.0"
+	Versions string `json:"versions"`
 }
 
 // OperatorConfig is configuration composed of key-value pairs to be injected into specified CSVs
@@ -159,6 +317,56 @@ type OperatorConfig struct {
 	// user or not. If set the value will propagate down to UserManaged field
 	// in the OperandRegistry
 	UserManaged bool `json:"userManaged,omitempty"`
+	// StartingCSV pins the operator to a ClusterServiceVersion, e.g.
+	// ibm-iam-operator.v4.12.0. The InstallPlans of a pinned operator are
+	// Manual, and only the InstallPlans of the pinned CSV are approved.
+	// +optional
+	StartingCSV string `json:"startingCSV,omitempty"`
+}
+
+// CustomOperator is an operator registered next to the built-in operators of
+// foundational services, with the defaults of its operands
+type CustomOperator struct {
+	// Name is the name of the operator as requested in an OperandRequest
+	Name string `json:"name"`
+	// PackageName is the name of the package of the operator in its catalog
+	PackageName string `json:"packageName"`
+	// Channel is the channel of the operator, the default channel of the
+	// package is used when it is not set
+	// +optional
+	Channel string `json:"channel,omitempty"`
+	// FallbackChannels are used in their order when the channel is not in the
+	// catalog
+	// +optional
+	FallbackChannels []string `json:"fallbackChannels,omitempty"`
+	// SourceName is the CatalogSource of the operator, it defaults to the
+	// CatalogSource of foundational services
+	// +optional
+	SourceName string `json:"sourceName,omitempty"`
+	// SourceNamespace is the namespace of the CatalogSource of the operator
+	// +optional
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+	// Namespace is the namespace the operator is installed in, it defaults to
+	// the namespace of the foundational services operators
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// InstallMode is the install mode of the operator: cluster, namespace, or
+	// no-op when it is not installed by ODLM
+	// +kubebuilder:validation:Enum=cluster;namespace;no-op
+	// +optional
+	InstallMode string `json:"installMode,omitempty"`
+	// Scope is the scope of the operator: public when it can be requested from
+	// any namespace, or private
+	// +kubebuilder:validation:Enum=public;private
+	// +optional
+	Scope string `json:"scope,omitempty"`
+	// Spec is the default spec of the operands of the operator in the
+	// OperandConfig
+	// +optional
+	Spec map[string]ExtensionWithMarker `json:"spec,omitempty"`
+	// Resources are the default resources of the operator in the OperandConfig
+	// +optional
+	Resources []ExtensionWithMarker `json:"resources,omitempty"`
 }
 
 // LicenseList defines the license specification in CSV
@@ -209,12 +417,179 @@ type CSPostgreSQLReplicaConfig struct {
 	// Bootstrap configuration for initial data sync via pg_basebackup
 	// +required
 	Bootstrap pgv1.BootstrapConfiguration `json:"bootstrap"`
+
+	// Action promotes the replica cluster to a primary, or demotes it back
+	// to a replica of the source cluster. It overrides replica.enabled.
+	// +kubebuilder:validation:Enum=Promote;Demote
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// Backup defines the scheduled backups of common-service-db, either to an
+// object store or to volume snapshots
+type Backup struct {
+	// Schedule is the cron schedule of the backups with seconds, e.g.
+	// "0 0 2 * * *" for every day at 02:00
+	Schedule string `json:"schedule"`
+	// Immediate takes a backup as soon as the schedule is created
+	// +optional
+	Immediate bool `json:"immediate,omitempty"`
+	// RetentionPolicy is how long the backups in the object store are kept,
+	// e.g. 30d, 4w or 6m
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]*[dwm]$`
+	// +optional
+	RetentionPolicy string `json:"retentionPolicy,omitempty"`
+	// ObjectStore is the object store of the backups and the WAL archive
+	// +optional
+	ObjectStore *BackupObjectStore `json:"objectStore,omitempty"`
+	// VolumeSnapshot takes the backups as volume snapshots
+	// +optional
+	VolumeSnapshot *BackupVolumeSnapshot `json:"volumeSnapshot,omitempty"`
+	// StaleAfter is the age of the last successful backup after which a
+	// warning is reported, 48h by default
+	// +optional
+	StaleAfter *metav1.Duration `json:"staleAfter,omitempty"`
+}
+
+// BackupObjectStore defines an S3 compatible object store
+type BackupObjectStore struct {
+	// DestinationPath is the path of the backups, e.g. s3://bucket/path
+	DestinationPath string `json:"destinationPath"`
+	// EndpointURL is the endpoint of the object store, the AWS S3 endpoint
+	// is used when it is not set
+	// +optional
+	EndpointURL string `json:"endpointURL,omitempty"`
+	// CredentialsSecret is the secret in the services namespace with the
+	// ACCESS_KEY_ID and ACCESS_SECRET_KEY keys
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// BackupVolumeSnapshot defines the volume snapshots of the backups
+type BackupVolumeSnapshot struct {
+	// ClassName is the VolumeSnapshotClass of the snapshots
+	ClassName string `json:"className"`
+}
+
+// Restore defines the recovery of common-service-db from either a Backup in
+// the services namespace or an object store
+type Restore struct {
+	// Name identifies the restore, change it to run another restore
+	Name string `json:"name"`
+	// Backup is the name of a completed Backup of common-service-db in the
+	// services namespace
+	// +optional
+	Backup string `json:"backup,omitempty"`
+	// ObjectStore is the object store with the base backups and the WAL
+	// archive of the database
+	// +optional
+	ObjectStore *BackupObjectStore `json:"objectStore,omitempty"`
+	// ServerName is the name of the database in the object store,
+	// common-service-db by default
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+	// TargetTime is the time to recover to in RFC 3339 format, e.g.
+	// 2026-10-18T09:30:00Z, the database is recovered to the end of the WAL
+	// archive when it is not set
+	// +optional
+	TargetTime string `json:"targetTime,omitempty"`
+	// OverwriteHealthyCluster allows the restore to replace a healthy
+	// common-service-db, the data written after the target time is lost
+	// +optional
+	OverwriteHealthyCluster bool `json:"overwriteHealthyCluster,omitempty"`
+}
+
+// BackupStatus describes the backups of common-service-db
+type BackupStatus struct {
+	// LastSuccessfulBackup is the time of the last successful backup
+	LastSuccessfulBackup string `json:"lastSuccessfulBackup,omitempty"`
+	// LastFailedBackup is the time of the last failed backup
+	LastFailedBackup string `json:"lastFailedBackup,omitempty"`
+	// FirstRecoverabilityPoint is the earliest time the database can be
+	// restored to
+	FirstRecoverabilityPoint string `json:"firstRecoverabilityPoint,omitempty"`
+	// Stale is true when the last successful backup is older than the
+	// staleAfter of the backup
+	Stale bool `json:"stale,omitempty"`
+}
+
+// RestoreStatus describes the progress of the restore of common-service-db
+type RestoreStatus struct {
+	// Name is the name of the restore
+	Name string `json:"name,omitempty"`
+	// Phase is one of Pending, Blocked, ScalingDown, Recovering, Completed
+	Phase string `json:"phase,omitempty"`
+	// Message describes the phase
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the restore was started
+	StartTime string `json:"startTime,omitempty"`
+	// RecoveryStartTime is the time the database was recreated from the
+	// backup
+	RecoveryStartTime string `json:"recoveryStartTime,omitempty"`
+	// CompletionTime is the time the restore was completed
+	CompletionTime string `json:"completionTime,omitempty"`
+}
+
+// PostgreSQLReplicaStatus describes the role and the replication of
+// common-service-db configured by CSPostgreSQLReplica
+type PostgreSQLReplicaStatus struct {
+	// Role is Primary or Replica
+	Role string `json:"role,omitempty"`
+	// CurrentPrimary is the instance that is the primary, or the designated
+	// primary of a replica cluster
+	CurrentPrimary string `json:"currentPrimary,omitempty"`
+	// ReplicationLag is the time since the last transaction replayed from
+	// the source cluster, it is only set for a replica
+	ReplicationLag string `json:"replicationLag,omitempty"`
+	// LastWALReceived is the location of the last WAL received from the
+	// source cluster, it is only set for a replica
+	LastWALReceived string `json:"lastWALReceived,omitempty"`
+	// Transitions are the latest promotions and demotions, the last one
+	// is in progress when it has no completionTime
+	Transitions []PostgreSQLReplicaTransition `json:"transitions,omitempty"`
+}
+
+// PostgreSQLReplicaTransition describes a promotion or a demotion of
+// common-service-db
+type PostgreSQLReplicaTransition struct {
+	// Action is Promote or Demote
+	Action string `json:"action"`
+	// StartTime is the time the action was started
+	StartTime string `json:"startTime,omitempty"`
+	// CompletionTime is the time the cluster reported its new role
+	CompletionTime string `json:"completionTime,omitempty"`
+	// Primary is the primary after the action
+	Primary string `json:"primary,omitempty"`
+}
+
+// InstallPlanApprovalStatus describes the InstallPlans approved by the
+// InstallPlanApprovalPolicy
+type InstallPlanApprovalStatus struct {
+	// Approved are the latest InstallPlans approved by the policy
+	// +optional
+	Approved []ApprovedInstallPlan `json:"approved,omitempty"`
+	// NotAllowed are the CSVs of the pending InstallPlans that are not
+	// allowed by the policy
+	// +optional
+	NotAllowed []string `json:"notAllowed,omitempty"`
+}
+
+// ApprovedInstallPlan describes an InstallPlan approved by the policy
+type ApprovedInstallPlan struct {
+	// Name is the name of the InstallPlan
+	Name string `json:"name"`
+	// Namespace is the namespace of the InstallPlan
+	Namespace string `json:"namespace"`
+	// ClusterServiceVersions are the CSVs installed by the InstallPlan
+	ClusterServiceVersions []string `json:"clusterServiceVersions,omitempty"`
+	// ApprovalTime is the time the InstallPlan was approved
+	ApprovalTime string `json:"approvalTime,omitempty"`
 }
 
 // BedrockOperator describes a list of foundational services' operators currently installed for this tenant.
 type BedrockOperator struct {
 	Name               string `json:"name,omitempty"`
 	Version            string `json:"version,omitempty"`
+	Channel            string `json:"channel,omitempty"`
 	OperatorStatus     string `json:"operatorStatus,omitempty"`
 	SubscriptionStatus string `json:"subscriptionStatus,omitempty"`
 	InstallPlanName    string `json:"installPlanName,omitempty"`
@@ -266,6 +641,38 @@ type ConfigStatus struct {
 	TopologyConfigurableCRs []ConfigurableCR `json:"topologyConfigurableCRs,omitempty"`
 }
 
+// AutoSizeStatus describes the size profile chosen for the CommonService CRs
+// with the auto size
+type AutoSizeStatus struct {
+	// Profile is the built-in size profile the auto size resolves to
+	Profile string `json:"profile,omitempty"`
+	// Reason explains why the profile was chosen
+	Reason string `json:"reason,omitempty"`
+	// Capacity is the cluster capacity observed when the profile was chosen
+	Capacity ClusterCapacity `json:"capacity,omitempty"`
+	// ObservedReevaluation is the value of the re-evaluation annotation
+	// handled by the last evaluation
+	ObservedReevaluation string `json:"observedReevaluation,omitempty"`
+	// LastEvaluationTime is the last time the profile was chosen
+	LastEvaluationTime string `json:"lastEvaluationTime,omitempty"`
+}
+
+// ClusterCapacity describes the cluster capacity used to choose the auto size
+type ClusterCapacity struct {
+	// Nodes is the number of schedulable nodes
+	Nodes int `json:"nodes,omitempty"`
+	// CPU is the allocatable CPU of the schedulable nodes
+	CPU string `json:"cpu,omitempty"`
+	// Memory is the allocatable memory of the schedulable nodes
+	Memory string `json:"memory,omitempty"`
+	// Architectures are the architectures of the nodes in the cluster
+	Architectures []string `json:"architectures,omitempty"`
+	// Tenants is the number of CommonService CRs
+	Tenants int `json:"tenants,omitempty"`
+	// OperandRequests is the number of OperandRequests
+	OperandRequests int `json:"operandRequests,omitempty"`
+}
+
 // HugePages defines the various hugepages settings applied to foundational services
 type HugePages struct {
 	// HugePagesEnabled enables hugepages settings for foundational services
@@ -274,6 +681,153 @@ type HugePages struct {
 	HugePagesSizes map[string]string `json:"-"`
 }
 
+// PodPlacement defines the scheduling constraints of pods
+type PodPlacement struct {
+	// NodeSelector must match the labels of the nodes the pods run on
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the pods to run on tainted nodes
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity describes the node affinity, pod affinity and pod anti-affinity,
+	// it is only applied to operands
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describe how the pods spread across topology
+	// domains, they are only applied to operands
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// Placement defines the pod placement applied to foundational services
+type Placement struct {
+	// The global placement applies to all the services
+	PodPlacement `json:",inline"`
+	// Services override the placement for individual services
+	// +optional
+	Services []ServicePlacement `json:"services,omitempty"`
+}
+
+// ServicePlacement defines the pod placement of a service
+type ServicePlacement struct {
+	// Name is the name of the service, e.g. ibm-im-operator. It also applies
+	// to the versioned services, e.g. ibm-im-operator-v4.0
+	Name         string `json:"name"`
+	PodPlacement `json:",inline"`
+}
+
+// PodDisruptionBudget defines the PodDisruptionBudgets of the operands
+type PodDisruptionBudget struct {
+	Enable bool `json:"enable"`
+	// MaxUnavailable is the number or percentage of pods that can be
+	// unavailable during a disruption, 1 by default
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ServiceStorage defines the volumes of a service
+type ServiceStorage struct {
+	// Name is the service in the OperandConfig, e.g. common-service-postgresql,
+	// it applies to all the versions of the service
+	Name string `json:"name"`
+	// StorageClass is the storage class of the volumes
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+	// Size is the size of the volumes, it is only supported by the
+	// PostgreSQL clusters
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// AccessModes are the access modes of the volumes, they are only
+	// supported by the PostgreSQL clusters
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
+// NetworkPolicy defines the NetworkPolicies of the services namespace
+type NetworkPolicy struct {
+	Enable bool `json:"enable"`
+	// AdditionalPeers are allowed to reach the pods of the services
+	// namespace, in addition to the foundational services, the tenant
+	// namespaces, the ingress controller, and the monitoring stack
+	// +optional
+	AdditionalPeers []networkingv1.NetworkPolicyPeer `json:"additionalPeers,omitempty"`
+}
+
+// Proxy defines the HTTP proxy settings
+type Proxy struct {
+	// HTTPProxy is the URL of the proxy for HTTP requests
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the URL of the proxy for HTTPS requests
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	// NoProxy is a comma-separated list of hostnames, domains, and CIDRs
+	// excluded from proxying. The service domains of the foundational services
+	// namespaces are added automatically.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// ImageRegistry defines how the images of the operands are rewritten
+type ImageRegistry struct {
+	// Mirrors rewrite the registry prefix of the images, the longest matching
+	// source is used
+	// +optional
+	Mirrors []RegistryMirror `json:"mirrors,omitempty"`
+	// Images override or pin individual images, they are applied before the
+	// mirrors
+	// +optional
+	Images []ImageOverride `json:"images,omitempty"`
+}
+
+// RegistryMirror defines a registry prefix rewrite rule
+type RegistryMirror struct {
+	// Source is the registry prefix of the images, e.g. icr.io/cpopen
+	Source string `json:"source"`
+	// Mirror replaces the source prefix, e.g. mirror.example.com/cpopen
+	Mirror string `json:"mirror"`
+}
+
+// ImageOverride defines the override or the digest pin of an image
+type ImageOverride struct {
+	// Name is the image repository without tag or digest, e.g.
+	// icr.io/cpopen/cpfs/cpfs-utils
+	Name string `json:"name"`
+	// Image replaces the images of the repository
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Digest is the digest the image must reference, e.g. sha256:<hex>
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// TLSSecurityProfile defines the minimum TLS version and the ciphers
+type TLSSecurityProfile struct {
+	// Type is the profile, Intermediate by default
+	// +kubebuilder:validation:Enum=Old;Intermediate;Modern;Custom
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Custom is the profile of the Custom type
+	// +optional
+	Custom *CustomTLSProfile `json:"custom,omitempty"`
+	// InheritFromCluster uses the tlsSecurityProfile of the cluster
+	// APIServer on OpenShift, Type and Custom are used on other clusters
+	// +optional
+	InheritFromCluster bool `json:"inheritFromCluster,omitempty"`
+}
+
+// CustomTLSProfile defines the minimum TLS version and the ciphers of a
+// Custom profile
+type CustomTLSProfile struct {
+	// Ciphers are the names of the allowed ciphers in OpenSSL format, the
+	// TLS 1.3 cipher suites use the IANA names, e.g. TLS_AES_128_GCM_SHA256
+	// +optional
+	Ciphers []string `json:"ciphers,omitempty"`
+	// MinTLSVersion is the minimum TLS version
+	// +kubebuilder:validation:Enum=VersionTLS10;VersionTLS11;VersionTLS12;VersionTLS13
+	MinTLSVersion string `json:"minTLSVersion"`
+}
+
 // CommonServiceStatus defines the observed state of CommonService
 type CommonServiceStatus struct {
 	// Phase describes the phase of the overall installation
@@ -282,6 +836,18 @@ type CommonServiceStatus struct {
 	// OverallStatus describes whether the Installation for the foundational services has succeeded or not
 	OverallStatus string       `json:"overallStatus,omitempty"`
 	ConfigStatus  ConfigStatus `json:"configStatus,omitempty"`
+	// AutoSize describes the size profile chosen for the auto size
+	AutoSize *AutoSizeStatus `json:"autoSize,omitempty"`
+	// Backup describes the backups of common-service-db
+	Backup *BackupStatus `json:"backup,omitempty"`
+	// Restore describes the progress of the restore of common-service-db
+	Restore *RestoreStatus `json:"restore,omitempty"`
+	// PostgreSQLReplica describes the role and the replication of
+	// common-service-db configured by CSPostgreSQLReplica
+	PostgreSQLReplica *PostgreSQLReplicaStatus `json:"postgreSQLReplica,omitempty"`
+	// InstallPlanApproval describes the InstallPlans approved by the
+	// InstallPlanApprovalPolicy
+	InstallPlanApproval *InstallPlanApprovalStatus `json:"installPlanApproval,omitempty"`
 	// Conditions represents the current state of CommonService
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Conditions",xDescriptors="urn:alm:descriptor:io.kubernetes.conditions"
@@ -325,6 +891,37 @@ const (
 	CRNotReady     string = "NotReady"
 )
 
+// Policies for the sizing that is invalid after merging
+const (
+	SizeValidationAutoFix string = "AutoFix"
+	SizeValidationReject  string = "Reject"
+)
+
+// Phases of the restore of common-service-db
+const (
+	RestorePending     string = "Pending"
+	RestoreBlocked     string = "Blocked"
+	RestoreScalingDown string = "ScalingDown"
+	RestoreRecovering  string = "Recovering"
+	RestoreCompleted   string = "Completed"
+)
+
+// Actions and roles of common-service-db configured by CSPostgreSQLReplica
+const (
+	ReplicaActionPromote string = "Promote"
+	ReplicaActionDemote  string = "Demote"
+	ReplicaRolePrimary   string = "Primary"
+	ReplicaRoleReplica   string = "Replica"
+)
+
+// TLS security profile types
+const (
+	TLSProfileOld          string = "Old"
+	TLSProfileIntermediate string = "Intermediate"
+	TLSProfileModern       string = "Modern"
+	TLSProfileCustom       string = "Custom"
+)
+
 const (
 	ConditionTypeBlocked     ConditionType = "Blocked"
 	ConditionTypeReady       ConditionType = "Ready"
@@ -341,14 +938,34 @@ const (
 	ConditionReasonWarning   = "WarningOccurred"
 	ConditionReasonError     = "ReconcileError"
 	ConditionReasonReady     = "ReconcileSucceeded"
+	// ConditionReasonVersionSkew is the reason of a warning for the
+	// installed operators not supported by this operator
+	ConditionReasonVersionSkew = "VersionSkew"
+	// ConditionReasonCatalogValidation is the reason of a warning for the
+	// packages and channels missing from the CatalogSources
+	ConditionReasonCatalogValidation = "CatalogValidationFailed"
+	// ConditionReasonStaleBackup is the reason of a warning for the last
+	// successful backup of common-service-db being stale
+	ConditionReasonStaleBackup = "StaleBackup"
 )
 
 const (
-	ConditionMessageReconcile = "reconciling CommonService CR."
-	ConditionMessageInit      = "initializing/updating: waiting for OperandRegistry and OperandConfig to become ready."
-	ConditionMessageConfig    = "configuring CommonService CR."
-	ConditionMessageMissSC    = "warning: StorageClass is not configured in CommonService CR, if KeyCloak or IBM IM service will be deployed, please configure StorageClass in the CS CR. Refer to the documentation for more information: https://www.ibm.com/docs/en/cloud-paks/foundational-services/4.6?topic=options-configuring-foundational-services#storage-class"
-	ConditionMessageReady     = "CommonService CR is ready."
+	ConditionMessageReconcile              = "reconciling CommonService CR."
+	ConditionMessageInit                   = "initializing/updating: waiting for OperandRegistry and OperandConfig to become ready."
+	ConditionMessageConfig                 = "configuring CommonService CR."
+	ConditionMessageMissSC                 = "warning: StorageClass is not configured in CommonService CR, if KeyCloak or IBM IM service will be deployed, please configure StorageClass in the CS CR. Refer to the documentation for more information: https://www.ibm.com/docs/en/cloud-paks/foundational-services/4.6?topic=options-configuring-foundational-services#storage-class"
+	ConditionMessageReady                  = "CommonService CR is ready."
+	ConditionMessageInvalidSizeProfile     = "warning: size profile is skipped: %v"
+	ConditionMessageInvalidTemplateOverlay = "warning: template overlay is skipped: %v"
+	ConditionMessageInvalidSizing          = "warning: sizing is invalid: %v"
+	ConditionMessageImageNotPinned         = "warning: image is not updated: %v"
+	ConditionMessageStorageClass           = "warning: StorageClass is not found: %v"
+	ConditionMessagePriorityClass          = "warning: PriorityClass is not found: %v"
+	ConditionMessageStaleBackup            = "warning: the last successful backup of common-service-db is older than %v: %s"
+	ConditionMessageRestoreBlocked         = "warning: restore %s of common-service-db is blocked: %s"
+	ConditionMessageTLSProfile             = "warning: TLS security profile %s is not applied to services: %s"
+	ConditionMessageVersionSkew            = "warning: the installed operators are not supported by IBM Common Service Operator %s: %s"
+	ConditionMessageCatalogValidation      = "warning: the catalogs can't install the operators of the foundational services: %s"
 )
 
 // +kubebuilder:object:root=true
@@ -488,6 +1105,18 @@ func (r *CommonService) setCondition(condition CommonServiceCondition) {
 	}
 }
 
+// RemoveConditionsByReason removes the conditions with the given reason, so
+// that a warning is cleared once its cause is gone
+func (r *CommonService) RemoveConditionsByReason(reason string) {
+	var conditions []CommonServiceCondition
+	for _, condition := range r.Status.Conditions {
+		if condition.Reason != reason {
+			conditions = append(conditions, condition)
+		}
+	}
+	r.Status.Conditions = conditions
+}
+
 func (r *CommonService) UpdateTopologyCR(CSData *CSData) {
 	var masterCRSlice []ConfigurableCR
 	var csCR ConfigurableCR
@@ -508,6 +1137,53 @@ func (r *CommonService) GetImagePullSecret() string {
 	return constant.DefaultImagePullSecret
 }
 
+// ForService returns the pod placement of a service. Each field set for the
+// service overrides the global one, the fields set for the exact service name
+// take precedence over the fields set for its unversioned name.
+func (p *Placement) ForService(name string) PodPlacement {
+	if p == nil {
+		return PodPlacement{}
+	}
+	placement := p.PodPlacement
+	for _, exact := range []bool{false, true} {
+		for _, service := range p.Services {
+			if exact && service.Name != name || !exact && !strings.HasPrefix(name, service.Name+"-v") {
+				continue
+			}
+			if service.NodeSelector != nil {
+				placement.NodeSelector = service.NodeSelector
+			}
+			if service.Tolerations != nil {
+				placement.Tolerations = service.Tolerations
+			}
+			if service.Affinity != nil {
+				placement.Affinity = service.Affinity
+			}
+			if service.TopologySpreadConstraints != nil {
+				placement.TopologySpreadConstraints = service.TopologySpreadConstraints
+			}
+		}
+	}
+	return placement
+}
+
+// Disabled returns true when the service is explicitly disabled
+func (s ServiceConfig) Disabled() bool {
+	return s.Enabled != nil && !*s.Enabled
+}
+
+// ServiceDisabled returns the disabled service of an operator, a service
+// disables the operator with its exact name or the versions of its
+// unversioned name
+func ServiceDisabled(services []ServiceConfig, name string) *ServiceConfig {
+	for i, service := range services {
+		if service.Disabled() && (service.Name == name || strings.HasPrefix(name, service.Name+"-v")) {
+			return &services[i]
+		}
+	}
+	return nil
+}
+
 func init() {
 	SchemeBuilder.Register(&CommonService{}, &CommonServiceList{})
 }
@@ -22,7 +22,11 @@ package v3
 
 import (
 	"github.ibm.com/ibm-pg/ibm-pg-types/pkg/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -40,6 +44,117 @@ func (in *APICatalog) DeepCopy() *APICatalog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovedInstallPlan) DeepCopyInto(out *ApprovedInstallPlan) {
+	*out = *in
+	if in.ClusterServiceVersions != nil {
+		in, out := &in.ClusterServiceVersions, &out.ClusterServiceVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovedInstallPlan.
+func (in *ApprovedInstallPlan) DeepCopy() *ApprovedInstallPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovedInstallPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoSizeStatus) DeepCopyInto(out *AutoSizeStatus) {
+	*out = *in
+	in.Capacity.DeepCopyInto(&out.Capacity)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoSizeStatus.
+func (in *AutoSizeStatus) DeepCopy() *AutoSizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoSizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backup) DeepCopyInto(out *Backup) {
+	*out = *in
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(BackupObjectStore)
+		**out = **in
+	}
+	if in.VolumeSnapshot != nil {
+		in, out := &in.VolumeSnapshot, &out.VolumeSnapshot
+		*out = new(BackupVolumeSnapshot)
+		**out = **in
+	}
+	if in.StaleAfter != nil {
+		in, out := &in.StaleAfter, &out.StaleAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backup.
+func (in *Backup) DeepCopy() *Backup {
+	if in == nil {
+		return nil
+	}
+	out := new(Backup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupObjectStore) DeepCopyInto(out *BackupObjectStore) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupObjectStore.
+func (in *BackupObjectStore) DeepCopy() *BackupObjectStore {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupObjectStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
+func (in *BackupStatus) DeepCopy() *BackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeSnapshot) DeepCopyInto(out *BackupVolumeSnapshot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSnapshot.
+func (in *BackupVolumeSnapshot) DeepCopy() *BackupVolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BedrockOperator) DeepCopyInto(out *BedrockOperator) {
 	*out = *in
@@ -125,6 +240,26 @@ func (in *CSPostgreSQLReplicaConfig) DeepCopy() *CSPostgreSQLReplicaConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapacity) DeepCopyInto(out *ClusterCapacity) {
+	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapacity.
+func (in *ClusterCapacity) DeepCopy() *ClusterCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonService) DeepCopyInto(out *CommonService) {
 	*out = *in
@@ -207,6 +342,11 @@ func (in *CommonServiceSpec) DeepCopyInto(out *CommonServiceSpec) {
 		*out = new(Features)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InstallPlanApprovalPolicy != nil {
+		in, out := &in.InstallPlanApprovalPolicy, &out.InstallPlanApprovalPolicy
+		*out = new(InstallPlanApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = make([]ServiceConfig, len(*in))
@@ -214,6 +354,18 @@ func (in *CommonServiceSpec) DeepCopyInto(out *CommonServiceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SizeFactors != nil {
+		in, out := &in.SizeFactors, &out.SizeFactors
+		*out = new(SizeFactors)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]ServiceStorage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -226,6 +378,43 @@ func (in *CommonServiceSpec) DeepCopyInto(out *CommonServiceSpec) {
 		*out = new(HugePages)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		**out = **in
+	}
+	if in.ImageRegistry != nil {
+		in, out := &in.ImageRegistry, &out.ImageRegistry
+		*out = new(ImageRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSSecurityProfile != nil {
+		in, out := &in.TLSSecurityProfile, &out.TLSSecurityProfile
+		*out = new(TLSSecurityProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomOperators != nil {
+		in, out := &in.CustomOperators, &out.CustomOperators
+		*out = make([]CustomOperator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.OperatorConfigs != nil {
 		in, out := &in.OperatorConfigs, &out.OperatorConfigs
 		*out = make([]OperatorConfig, len(*in))
@@ -234,11 +423,26 @@ func (in *CommonServiceSpec) DeepCopyInto(out *CommonServiceSpec) {
 		}
 	}
 	out.License = in.License
+	if in.AutoScaleConfig != nil {
+		in, out := &in.AutoScaleConfig, &out.AutoScaleConfig
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CSPostgreSQLReplica != nil {
 		in, out := &in.CSPostgreSQLReplica, &out.CSPostgreSQLReplica
 		*out = new(CSPostgreSQLReplicaConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(Backup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(Restore)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonServiceSpec.
@@ -260,6 +464,31 @@ func (in *CommonServiceStatus) DeepCopyInto(out *CommonServiceStatus) {
 		copy(*out, *in)
 	}
 	in.ConfigStatus.DeepCopyInto(&out.ConfigStatus)
+	if in.AutoSize != nil {
+		in, out := &in.AutoSize, &out.AutoSize
+		*out = new(AutoSizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupStatus)
+		**out = **in
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(RestoreStatus)
+		**out = **in
+	}
+	if in.PostgreSQLReplica != nil {
+		in, out := &in.PostgreSQLReplica, &out.PostgreSQLReplica
+		*out = new(PostgreSQLReplicaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstallPlanApproval != nil {
+		in, out := &in.InstallPlanApproval, &out.InstallPlanApproval
+		*out = new(InstallPlanApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]CommonServiceCondition, len(*in))
@@ -312,6 +541,60 @@ func (in *ConfigurableCR) DeepCopy() *ConfigurableCR {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOperator) DeepCopyInto(out *CustomOperator) {
+	*out = *in
+	if in.FallbackChannels != nil {
+		in, out := &in.FallbackChannels, &out.FallbackChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = make(map[string]ExtensionWithMarker, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ExtensionWithMarker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOperator.
+func (in *CustomOperator) DeepCopy() *CustomOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTLSProfile) DeepCopyInto(out *CustomTLSProfile) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTLSProfile.
+func (in *CustomTLSProfile) DeepCopy() *CustomTLSProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTLSProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionWithMarker) DeepCopyInto(out *ExtensionWithMarker) {
 	*out = *in
@@ -375,6 +658,94 @@ func (in *HugePages) DeepCopy() *HugePages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOverride) DeepCopyInto(out *ImageOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOverride.
+func (in *ImageOverride) DeepCopy() *ImageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistry) DeepCopyInto(out *ImageRegistry) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]RegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageOverride, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistry.
+func (in *ImageRegistry) DeepCopy() *ImageRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallPlanApprovalPolicy) DeepCopyInto(out *InstallPlanApprovalPolicy) {
+	*out = *in
+	out.MaintenanceWindow = in.MaintenanceWindow
+	if in.AllowedVersions != nil {
+		in, out := &in.AllowedVersions, &out.AllowedVersions
+		*out = make([]OperatorVersionRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallPlanApprovalPolicy.
+func (in *InstallPlanApprovalPolicy) DeepCopy() *InstallPlanApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallPlanApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallPlanApprovalStatus) DeepCopyInto(out *InstallPlanApprovalStatus) {
+	*out = *in
+	if in.Approved != nil {
+		in, out := &in.Approved, &out.Approved
+		*out = make([]ApprovedInstallPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotAllowed != nil {
+		in, out := &in.NotAllowed, &out.NotAllowed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallPlanApprovalStatus.
+func (in *InstallPlanApprovalStatus) DeepCopy() *InstallPlanApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallPlanApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LicenseList) DeepCopyInto(out *LicenseList) {
 	*out = *in
@@ -390,6 +761,44 @@ func (in *LicenseList) DeepCopy() *LicenseList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+	if in.AdditionalPeers != nil {
+		in, out := &in.AdditionalPeers, &out.AdditionalPeers
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorConfig) DeepCopyInto(out *OperatorConfig) {
 	*out = *in
@@ -410,6 +819,220 @@ func (in *OperatorConfig) DeepCopy() *OperatorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorVersionRange) DeepCopyInto(out *OperatorVersionRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorVersionRange.
+func (in *OperatorVersionRange) DeepCopy() *OperatorVersionRange {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorVersionRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	in.PodPlacement.DeepCopyInto(&out.PodPlacement)
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServicePlacement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacement) DeepCopyInto(out *PodPlacement) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacement.
+func (in *PodPlacement) DeepCopy() *PodPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLReplicaStatus) DeepCopyInto(out *PostgreSQLReplicaStatus) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]PostgreSQLReplicaTransition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLReplicaStatus.
+func (in *PostgreSQLReplicaStatus) DeepCopy() *PostgreSQLReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLReplicaTransition) DeepCopyInto(out *PostgreSQLReplicaTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLReplicaTransition.
+func (in *PostgreSQLReplicaTransition) DeepCopy() *PostgreSQLReplicaTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLReplicaTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Restore) DeepCopyInto(out *Restore) {
+	*out = *in
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(BackupObjectStore)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
+	if in == nil {
+		return nil
+	}
+	out := new(Restore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingFactors) DeepCopyInto(out *ScalingFactors) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingFactors.
+func (in *ScalingFactors) DeepCopy() *ScalingFactors {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingFactors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceConfig) DeepCopyInto(out *ServiceConfig) {
 	*out = *in
@@ -427,6 +1050,11 @@ func (in *ServiceConfig) DeepCopyInto(out *ServiceConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceConfig.
@@ -438,3 +1066,101 @@ func (in *ServiceConfig) DeepCopy() *ServiceConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlacement) DeepCopyInto(out *ServicePlacement) {
+	*out = *in
+	in.PodPlacement.DeepCopyInto(&out.PodPlacement)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlacement.
+func (in *ServicePlacement) DeepCopy() *ServicePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSizeFactors) DeepCopyInto(out *ServiceSizeFactors) {
+	*out = *in
+	out.ScalingFactors = in.ScalingFactors
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSizeFactors.
+func (in *ServiceSizeFactors) DeepCopy() *ServiceSizeFactors {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSizeFactors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceStorage) DeepCopyInto(out *ServiceStorage) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStorage.
+func (in *ServiceStorage) DeepCopy() *ServiceStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SizeFactors) DeepCopyInto(out *SizeFactors) {
+	*out = *in
+	out.ScalingFactors = in.ScalingFactors
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceSizeFactors, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SizeFactors.
+func (in *SizeFactors) DeepCopy() *SizeFactors {
+	if in == nil {
+		return nil
+	}
+	out := new(SizeFactors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecurityProfile) DeepCopyInto(out *TLSSecurityProfile) {
+	*out = *in
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomTLSProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecurityProfile.
+func (in *TLSSecurityProfile) DeepCopy() *TLSSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// backupClusters are the services and API versions of common-service-db
+var backupClusters = []struct {
+	service    string
+	apiVersion string
+}{
+	{service: "common-service-postgresql", apiVersion: "postgresql.k8s.enterprisedb.io/v1"},
+	{service: "common-service-cnpg", apiVersion: "pg.ibm.com/v1"},
+}
+
+// ValidateBackup checks the schedule and that exactly one backup target is set
+func ValidateBackup(backup *apiv3.Backup) error {
+	if backup == nil {
+		return nil
+	}
+	// the schedule has six fields, the first one is for the seconds
+	if fields := strings.Fields(backup.Schedule); len(fields) != 6 {
+		return fmt.Errorf("invalid schedule %q, it must have six fields including the seconds, e.g. \"0 0 2 * * *\"", backup.Schedule)
+	}
+	if (backup.ObjectStore == nil) == (backup.VolumeSnapshot == nil) {
+		return fmt.Errorf("backup must set either objectStore or volumeSnapshot")
+	}
+	if store := backup.ObjectStore; store != nil {
+		if store.DestinationPath == "" || store.CredentialsSecret == "" {
+			return fmt.Errorf("backup objectStore must set destinationPath and credentialsSecret")
+		}
+	}
+	if backup.VolumeSnapshot != nil && backup.VolumeSnapshot.ClassName == "" {
+		return fmt.Errorf("backup volumeSnapshot must set className")
+	}
+	if backup.StaleAfter != nil && backup.StaleAfter.Duration <= 0 {
+		return fmt.Errorf("backup staleAfter must be positive")
+	}
+	return nil
+}
+
+// backupClusterSpec returns the backup configuration of the Cluster
+func backupClusterSpec(backup *apiv3.Backup) map[string]interface{} {
+	spec := map[string]interface{}{}
+	if backup.RetentionPolicy != "" {
+		spec["retentionPolicy"] = backup.RetentionPolicy
+	}
+	if store := backup.ObjectStore; store != nil {
+		objectStore := map[string]interface{}{
+			"destinationPath": store.DestinationPath,
+			"s3Credentials": map[string]interface{}{
+				"accessKeyId":     map[string]interface{}{"name": store.CredentialsSecret, "key": "ACCESS_KEY_ID"},
+				"secretAccessKey": map[string]interface{}{"name": store.CredentialsSecret, "key": "ACCESS_SECRET_KEY"},
+			},
+		}
+		if store.EndpointURL != "" {
+			objectStore["endpointURL"] = store.EndpointURL
+		}
+		spec["barmanObjectStore"] = objectStore
+	}
+	if snapshot := backup.VolumeSnapshot; snapshot != nil {
+		spec["volumeSnapshot"] = map[string]interface{}{"className": snapshot.ClassName}
+	}
+	return spec
+}
+
+// extractBackupConfigs converts the backup to the OperandConfig format. The
+// backup configuration is merged into the common-service-db Cluster, and the
+// ScheduledBackup is added to the resources of the service.
+func extractBackupConfigs(backup *apiv3.Backup) ([]interface{}, error) {
+	if err := ValidateBackup(backup); err != nil {
+		return nil, err
+	}
+
+	method := "barmanObjectStore"
+	if backup.VolumeSnapshot != nil {
+		method = "volumeSnapshot"
+	}
+
+	var configs []interface{}
+	for _, cluster := range backupClusters {
+		scheduledBackup := map[string]interface{}{
+			"schedule":             backup.Schedule,
+			"cluster":              map[string]interface{}{"name": constant.CSPGCluster},
+			"method":               method,
+			"backupOwnerReference": "self",
+		}
+		if backup.Immediate {
+			scheduledBackup["immediate"] = true
+		}
+
+		configs = append(configs, map[string]interface{}{
+			"name": cluster.service,
+			"resources": []interface{}{
+				map[string]interface{}{
+					"apiVersion": cluster.apiVersion,
+					"kind":       "Cluster",
+					"name":       constant.CSPGCluster,
+					"data": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"backup": backupClusterSpec(backup),
+						},
+					},
+				},
+				map[string]interface{}{
+					"apiVersion": cluster.apiVersion,
+					"kind":       "ScheduledBackup",
+					"name":       constant.CSPGScheduledBackup,
+					"force":      true,
+					"data": map[string]interface{}{
+						"spec": scheduledBackup,
+					},
+				},
+			},
+		})
+	}
+	return configs, nil
+}
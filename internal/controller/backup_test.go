@@ -0,0 +1,87 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestValidateBackup(t *testing.T) {
+	store := &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs", CredentialsSecret: "backup-creds"}
+	snapshot := &apiv3.BackupVolumeSnapshot{ClassName: "csi-snapclass"}
+
+	assert.NoError(t, ValidateBackup(nil))
+	assert.NoError(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", ObjectStore: store}))
+	assert.NoError(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", VolumeSnapshot: snapshot}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 2 * * *", ObjectStore: store}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *"}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", ObjectStore: store, VolumeSnapshot: snapshot}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", ObjectStore: &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs"}}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", VolumeSnapshot: &apiv3.BackupVolumeSnapshot{}}))
+	assert.Error(t, ValidateBackup(&apiv3.Backup{Schedule: "0 0 2 * * *", VolumeSnapshot: snapshot, StaleAfter: &metav1.Duration{Duration: -time.Hour}}))
+}
+
+func TestExtractBackupConfigs(t *testing.T) {
+	configs, err := extractBackupConfigs(&apiv3.Backup{
+		Schedule:        "0 0 2 * * *",
+		Immediate:       true,
+		RetentionPolicy: "30d",
+		ObjectStore:     &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs", EndpointURL: "https://s3.example.com", CredentialsSecret: "backup-creds"},
+	})
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+
+	service := configs[0].(map[string]interface{})
+	assert.Equal(t, "common-service-postgresql", service["name"])
+	resources := service["resources"].([]interface{})
+	require.Len(t, resources, 2)
+
+	cluster := resources[0].(map[string]interface{})
+	assert.Equal(t, "postgresql.k8s.enterprisedb.io/v1", cluster["apiVersion"])
+	backup := cluster["data"].(map[string]interface{})["spec"].(map[string]interface{})["backup"].(map[string]interface{})
+	assert.Equal(t, "30d", backup["retentionPolicy"])
+	objectStore := backup["barmanObjectStore"].(map[string]interface{})
+	assert.Equal(t, "s3://backups/cs", objectStore["destinationPath"])
+	assert.Equal(t, "https://s3.example.com", objectStore["endpointURL"])
+	assert.Equal(t, map[string]interface{}{"name": "backup-creds", "key": "ACCESS_KEY_ID"}, objectStore["s3Credentials"].(map[string]interface{})["accessKeyId"])
+
+	scheduledBackup := resources[1].(map[string]interface{})
+	assert.Equal(t, "ScheduledBackup", scheduledBackup["kind"])
+	assert.Equal(t, map[string]interface{}{
+		"schedule":             "0 0 2 * * *",
+		"cluster":              map[string]interface{}{"name": "common-service-db"},
+		"method":               "barmanObjectStore",
+		"backupOwnerReference": "self",
+		"immediate":            true,
+	}, scheduledBackup["data"].(map[string]interface{})["spec"])
+
+	assert.Equal(t, "common-service-cnpg", configs[1].(map[string]interface{})["name"])
+
+	configs, err = extractBackupConfigs(&apiv3.Backup{Schedule: "0 0 2 * * *", VolumeSnapshot: &apiv3.BackupVolumeSnapshot{ClassName: "csi-snapclass"}})
+	require.NoError(t, err)
+	resources = configs[1].(map[string]interface{})["resources"].([]interface{})
+	backup = resources[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["backup"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"volumeSnapshot": map[string]interface{}{"className": "csi-snapclass"}}, backup)
+	assert.Equal(t, "volumeSnapshot", resources[1].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["method"])
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// MaxNodeAllocatable returns the largest allocatable amount of each resource
+// across the schedulable nodes, the tainted nodes are included when the pod
+// placement tolerates their taints. It returns false when the nodes can't be
+// listed or none of them is schedulable, so that the node allocatable checks
+// are skipped.
+func (b *Bootstrap) MaxNodeAllocatable(ctx context.Context, placement *apiv3.Placement) (corev1.ResourceList, bool) {
+	allowed, err := b.CanI(ctx, "", "nodes", "list", "")
+	if err != nil {
+		klog.Warningf("SSAR check for nodes list failed: %v, skipping node allocatable check", err)
+		return nil, false
+	}
+	if !allowed {
+		klog.V(2).Info("No permission to list nodes, skipping node allocatable check")
+		return nil, false
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := b.Reader.List(ctx, nodeList); err != nil {
+		klog.Warningf("Failed to list nodes: %v, skipping node allocatable check", err)
+		return nil, false
+	}
+
+	tolerations := placementTolerations(placement)
+	allocatable := corev1.ResourceList{}
+	matched := false
+	for _, node := range nodeList.Items {
+		if !isSchedulableWith(&node, tolerations) {
+			continue
+		}
+		matched = true
+		for name, quantity := range node.Status.Allocatable {
+			if current, ok := allocatable[name]; !ok || quantity.Cmp(current) > 0 {
+				allocatable[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	return allocatable, matched
+}
+
+// placementTolerations returns the global tolerations of the pod placement and
+// the tolerations of its services, the sizing is validated for all services
+func placementTolerations(placement *apiv3.Placement) []corev1.Toleration {
+	if placement == nil {
+		return nil
+	}
+	tolerations := append([]corev1.Toleration{}, placement.Tolerations...)
+	for _, service := range placement.Services {
+		tolerations = append(tolerations, service.Tolerations...)
+	}
+	return tolerations
+}
+
+// SetSizingIssues records the issues found in the sizing of the OperandConfig,
+// they are reported as warnings in the master CommonService CR
+func (b *Bootstrap) SetSizingIssues(issues []string) {
+	b.sizingIssues = issues
+}
+
+// checkSizingWarning sets a warning for each sizing issue
+func (b *Bootstrap) checkSizingWarning(instance *apiv3.CommonService) {
+	for _, issue := range b.sizingIssues {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageInvalidSizing, issue))
+	}
+}
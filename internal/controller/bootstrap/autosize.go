@@ -0,0 +1,342 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+)
+
+// autoSizeChangeThreshold is the relative change of the cluster capacity
+// that triggers a new evaluation of the auto size
+const autoSizeChangeThreshold = 0.2
+
+// autoSizeTier is the capacity a built-in profile needs, and the demand it is
+// chosen for
+type autoSizeTier struct {
+	profile         string
+	nodes           int
+	cpu             resource.Quantity
+	memory          resource.Quantity
+	operandRequests int
+	tenants         int
+}
+
+// autoSizeTiers are ordered from the largest profile to the smallest one,
+// starterset is chosen when no tier matches
+var autoSizeTiers = []autoSizeTier{
+	{profile: size.LargeProfile, nodes: 3, cpu: resource.MustParse("48"), memory: resource.MustParse("192Gi"), operandRequests: 40, tenants: 5},
+	{profile: size.MediumProfile, nodes: 3, cpu: resource.MustParse("24"), memory: resource.MustParse("96Gi"), operandRequests: 20, tenants: 3},
+	{profile: size.SmallProfile, nodes: 2, cpu: resource.MustParse("12"), memory: resource.MustParse("48Gi"), operandRequests: 5, tenants: 2},
+}
+
+// EvaluateAutoSize chooses the built-in profile for the CommonService CRs with
+// the auto size and records the choice in the status of the instance. The
+// recorded choice is kept until it is requested again with the re-evaluation
+// annotation, or the cluster capacity changes significantly.
+func (b *Bootstrap) EvaluateAutoSize(ctx context.Context, instance *apiv3.CommonService) error {
+	csList := &apiv3.CommonServiceList{}
+	if err := b.Client.List(ctx, csList); err != nil {
+		return fmt.Errorf("failed to list CommonService CRs: %v", err)
+	}
+
+	autoUsed := instance.Spec.Size == size.AutoProfile
+	tenants := 0
+	for _, cs := range csList.Items {
+		if cs.GetDeletionTimestamp() != nil || cs.Labels[constant.CsClonedFromLabel] != "" {
+			continue
+		}
+		tenants++
+		if cs.Spec.Size == size.AutoProfile {
+			autoUsed = true
+		}
+	}
+
+	if !autoUsed {
+		instance.Status.AutoSize = nil
+		return size.SetAutoProfile("")
+	}
+
+	capacity, note := b.observeCapacity(ctx, tenants)
+	request := instance.GetAnnotations()[constant.AutoSizeReevaluateAnnotation]
+
+	previous := instance.Status.AutoSize
+	if previous != nil && previous.ObservedReevaluation == request && !significantCapacityChange(previous.Capacity, capacity) {
+		if err := size.SetAutoProfile(previous.Profile); err == nil {
+			klog.V(2).Infof("Keeping auto size %s, the cluster capacity has not changed significantly", previous.Profile)
+			return nil
+		}
+	}
+
+	profile, reason := chooseAutoSize(capacity)
+	if note != "" {
+		reason = note + "; " + reason
+	}
+	klog.Infof("Auto size resolves to %s: %s", profile, reason)
+	if b.EventRecorder != nil && (previous == nil || previous.Profile != profile) {
+		b.EventRecorder.Eventf(instance, corev1.EventTypeNormal, "AutoSize", "Auto size resolves to %s: %s", profile, reason)
+	}
+
+	instance.Status.AutoSize = &apiv3.AutoSizeStatus{
+		Profile:              profile,
+		Reason:               reason,
+		Capacity:             capacity,
+		ObservedReevaluation: request,
+		LastEvaluationTime:   time.Now().Format(time.RFC3339),
+	}
+	return size.SetAutoProfile(profile)
+}
+
+// RestoreAutoSize resolves the auto size from the choice recorded in the
+// master CommonService CR, so that it is available before the master CR is
+// reconciled again after a restart
+func (b *Bootstrap) RestoreAutoSize(ctx context.Context) error {
+	if b.autoSizeRestored {
+		return nil
+	}
+
+	masterCR := &apiv3.CommonService{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: b.CSData.OperatorNs}, masterCR); err != nil {
+		if errors.IsNotFound(err) {
+			b.autoSizeRestored = true
+			return nil
+		}
+		return err
+	}
+	if masterCR.Status.AutoSize != nil && size.GetAutoProfile() == "" {
+		if err := size.SetAutoProfile(masterCR.Status.AutoSize.Profile); err != nil {
+			klog.Warningf("Failed to restore auto size: %v", err)
+		}
+	}
+	b.autoSizeRestored = true
+	return nil
+}
+
+// observeCapacity returns the capacity of the schedulable nodes with the
+// architecture of the operator, and the demand on foundational services. The
+// note explains the capacity that could not be observed.
+func (b *Bootstrap) observeCapacity(ctx context.Context, tenants int) (apiv3.ClusterCapacity, string) {
+	capacity := apiv3.ClusterCapacity{Tenants: tenants}
+	var notes []string
+
+	cpu := resource.MustParse("0")
+	memory := resource.MustParse("0")
+	allowed, err := b.CanI(ctx, "", "nodes", "list", "")
+	if err != nil {
+		klog.Warningf("SSAR check for nodes list failed: %v", err)
+	}
+	if !allowed {
+		klog.Warning("No permission to list nodes, the auto size can't use the node capacity")
+		notes = append(notes, "node capacity is unknown, grant the operator permission to list nodes")
+	} else {
+		nodeList := &corev1.NodeList{}
+		if err := b.Reader.List(ctx, nodeList); err != nil {
+			klog.Warningf("Failed to list nodes: %v", err)
+			notes = append(notes, "node capacity is unknown, failed to list nodes")
+		}
+		arches := map[string]bool{}
+		for _, node := range nodeList.Items {
+			arch := node.Labels[corev1.LabelArchStable]
+			if arch != "" {
+				arches[arch] = true
+			}
+			if !isSchedulable(&node) || (arch != "" && arch != runtime.GOARCH) {
+				continue
+			}
+			capacity.Nodes++
+			cpu.Add(node.Status.Allocatable[corev1.ResourceCPU])
+			memory.Add(node.Status.Allocatable[corev1.ResourceMemory])
+		}
+		for arch := range arches {
+			capacity.Architectures = append(capacity.Architectures, arch)
+		}
+		sort.Strings(capacity.Architectures)
+	}
+	capacity.CPU = cpu.String()
+	capacity.Memory = memory.String()
+
+	operandRequests, err := b.countOperandRequests(ctx)
+	if err != nil {
+		klog.Warningf("Failed to count OperandRequests: %v", err)
+		notes = append(notes, "failed to count OperandRequests")
+	}
+	capacity.OperandRequests = operandRequests
+
+	return capacity, strings.Join(notes, ", ")
+}
+
+// countOperandRequests counts the OperandRequests in the cluster, or in the
+// watched namespaces when the operator can't list them cluster-wide
+func (b *Bootstrap) countOperandRequests(ctx context.Context) (int, error) {
+	var namespaces []string
+	if allowed, err := b.CanI(ctx, "operator.ibm.com", "operandrequests", "list", ""); err != nil || !allowed {
+		for _, ns := range strings.Split(b.CSData.WatchNamespaces, ",") {
+			if ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+		if len(namespaces) == 0 {
+			namespaces = append(namespaces, b.CSData.ServicesNs)
+		}
+	} else {
+		namespaces = append(namespaces, "")
+	}
+
+	count := 0
+	for _, ns := range namespaces {
+		opreqList := &odlm.OperandRequestList{}
+		if err := b.Reader.List(ctx, opreqList, client.InNamespace(ns)); err != nil {
+			return count, err
+		}
+		count += len(opreqList.Items)
+	}
+	return count, nil
+}
+
+// chooseAutoSize picks the profile needed by the tenants and OperandRequests,
+// capped by the profile the node capacity can run
+func chooseAutoSize(capacity apiv3.ClusterCapacity) (string, string) {
+	cpu, _ := resource.ParseQuantity(capacity.CPU)
+	memory, _ := resource.ParseQuantity(capacity.Memory)
+
+	capacityProfile := size.StarterSetProfile
+	for _, tier := range autoSizeTiers {
+		if capacity.Nodes >= tier.nodes && cpu.Cmp(tier.cpu) >= 0 && memory.Cmp(tier.memory) >= 0 {
+			capacityProfile = tier.profile
+			break
+		}
+	}
+
+	demandProfile := size.StarterSetProfile
+	for _, tier := range autoSizeTiers {
+		if capacity.OperandRequests >= tier.operandRequests || capacity.Tenants >= tier.tenants {
+			demandProfile = tier.profile
+			break
+		}
+	}
+
+	profile := demandProfile
+	capacityPriority, _ := size.Priority(capacityProfile)
+	demandPriority, _ := size.Priority(demandProfile)
+	if capacityPriority < demandPriority {
+		profile = capacityProfile
+	}
+
+	reason := fmt.Sprintf("%d schedulable %s nodes with %s CPU and %s memory can run the %s profile, %d OperandRequests across %d tenants need the %s profile",
+		capacity.Nodes, runtime.GOARCH, capacity.CPU, capacity.Memory, capacityProfile, capacity.OperandRequests, capacity.Tenants, demandProfile)
+	return profile, reason
+}
+
+// significantCapacityChange reports whether the capacity changed enough since
+// the last evaluation to choose the auto size again
+func significantCapacityChange(previous, current apiv3.ClusterCapacity) bool {
+	if !reflect.DeepEqual(previous.Architectures, current.Architectures) {
+		return true
+	}
+	if significantChange(float64(previous.Nodes), float64(current.Nodes)) ||
+		significantChange(float64(previous.Tenants), float64(current.Tenants)) ||
+		significantChange(float64(previous.OperandRequests), float64(current.OperandRequests)) {
+		return true
+	}
+	for _, quantities := range [][2]string{{previous.CPU, current.CPU}, {previous.Memory, current.Memory}} {
+		previousQuantity, err := resource.ParseQuantity(quantities[0])
+		if err != nil {
+			return true
+		}
+		currentQuantity, err := resource.ParseQuantity(quantities[1])
+		if err != nil {
+			return true
+		}
+		if significantChange(previousQuantity.AsApproximateFloat64(), currentQuantity.AsApproximateFloat64()) {
+			return true
+		}
+	}
+	return false
+}
+
+func significantChange(previous, current float64) bool {
+	if previous == 0 {
+		return current != 0
+	}
+	return math.Abs(current-previous)/previous > autoSizeChangeThreshold
+}
+
+// isSchedulable reports whether workloads without tolerations can run on the node
+func isSchedulable(node *corev1.Node) bool {
+	return isSchedulableWith(node, nil)
+}
+
+// isSchedulableWith returns true when the pods with the tolerations can be
+// scheduled on the node
+func isSchedulableWith(node *corev1.Node, tolerations []corev1.Toleration) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range tolerations {
+			if toleratesTaint(&tolerations[j], taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// toleratesTaint returns true when the toleration matches the taint, like the
+// scheduler does
+func toleratesTaint(toleration *corev1.Toleration, taint *corev1.Taint) bool {
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+	if toleration.Key != "" && toleration.Key != taint.Key {
+		return false
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		return true
+	case "", corev1.TolerationOpEqual:
+		return toleration.Key != "" && toleration.Value == taint.Value
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+)
+
+func TestChooseAutoSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity apiv3.ClusterCapacity
+		want     string
+	}{
+		{"single node", apiv3.ClusterCapacity{Nodes: 1, CPU: "8", Memory: "32Gi", OperandRequests: 50, Tenants: 1}, size.StarterSetProfile},
+		{"no demand", apiv3.ClusterCapacity{Nodes: 6, CPU: "96", Memory: "384Gi", OperandRequests: 2, Tenants: 1}, size.StarterSetProfile},
+		{"demand capped by capacity", apiv3.ClusterCapacity{Nodes: 3, CPU: "24", Memory: "96Gi", OperandRequests: 60, Tenants: 1}, size.MediumProfile},
+		{"tenants", apiv3.ClusterCapacity{Nodes: 6, CPU: "96", Memory: "384Gi", OperandRequests: 0, Tenants: 5}, size.LargeProfile},
+		{"operand requests", apiv3.ClusterCapacity{Nodes: 2, CPU: "16", Memory: "64Gi", OperandRequests: 8, Tenants: 1}, size.SmallProfile},
+		{"memory bound", apiv3.ClusterCapacity{Nodes: 6, CPU: "96", Memory: "64Gi", OperandRequests: 60, Tenants: 1}, size.SmallProfile},
+		{"unknown capacity", apiv3.ClusterCapacity{CPU: "0", Memory: "0", OperandRequests: 60, Tenants: 1}, size.StarterSetProfile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, reason := chooseAutoSize(tt.capacity)
+			assert.Equal(t, tt.want, profile)
+			assert.NotEmpty(t, reason)
+		})
+	}
+}
+
+func TestSignificantCapacityChange(t *testing.T) {
+	previous := apiv3.ClusterCapacity{Nodes: 5, CPU: "40", Memory: "160Gi", Architectures: []string{"amd64"}, Tenants: 2, OperandRequests: 10}
+
+	same := previous
+	assert.False(t, significantCapacityChange(previous, same))
+
+	small := previous
+	small.Nodes = 6
+	small.CPU = "44"
+	small.Memory = "170Gi"
+	assert.False(t, significantCapacityChange(previous, small), "changes within the threshold must not trigger a new evaluation")
+
+	cpu := previous
+	cpu.CPU = "60"
+	assert.True(t, significantCapacityChange(previous, cpu))
+
+	memory := previous
+	memory.Memory = "100Gi"
+	assert.True(t, significantCapacityChange(previous, memory))
+
+	operandRequests := previous
+	operandRequests.OperandRequests = 20
+	assert.True(t, significantCapacityChange(previous, operandRequests))
+
+	arches := previous
+	arches.Architectures = []string{"amd64", "s390x"}
+	assert.True(t, significantCapacityChange(previous, arches))
+}
+
+func TestIsSchedulable(t *testing.T) {
+	assert.True(t, isSchedulable(&corev1.Node{}))
+	assert.False(t, isSchedulable(&corev1.Node{Spec: corev1.NodeSpec{Unschedulable: true}}))
+	assert.False(t, isSchedulable(&corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+		{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule},
+	}}}))
+	assert.True(t, isSchedulable(&corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+		{Key: "example", Effect: corev1.TaintEffectPreferNoSchedule},
+	}}}))
+
+	infra := &corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+		{Key: "node-role.kubernetes.io/infra", Value: "reserved", Effect: corev1.TaintEffectNoSchedule},
+	}}}
+	assert.True(t, isSchedulableWith(infra, []corev1.Toleration{
+		{Key: "node-role.kubernetes.io/infra", Value: "reserved", Effect: corev1.TaintEffectNoSchedule},
+	}))
+	assert.True(t, isSchedulableWith(infra, []corev1.Toleration{
+		{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists},
+	}))
+	assert.False(t, isSchedulableWith(infra, []corev1.Toleration{
+		{Key: "node-role.kubernetes.io/infra", Value: "other", Effect: corev1.TaintEffectNoSchedule},
+	}))
+	assert.False(t, isSchedulableWith(infra, []corev1.Toleration{
+		{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	}))
+}
+
+func TestEvaluateAutoSize(t *testing.T) {
+	defer func() { _ = size.SetAutoProfile("") }()
+
+	b := buildTestBootstrap(t)
+	b.CSData.OperatorNs = "cs-operator"
+	ctx := context.Background()
+
+	instance := &apiv3.CommonService{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: "cs-operator"},
+		Spec:       apiv3.CommonServiceSpec{Size: size.AutoProfile},
+	}
+	require.NoError(t, b.Client.Create(ctx, instance.DeepCopy()))
+
+	require.NoError(t, b.EvaluateAutoSize(ctx, instance))
+	require.NotNil(t, instance.Status.AutoSize)
+	assert.Equal(t, size.StarterSetProfile, instance.Status.AutoSize.Profile)
+	assert.Contains(t, instance.Status.AutoSize.Reason, "node capacity is unknown")
+	assert.Equal(t, 1, instance.Status.AutoSize.Capacity.Tenants)
+	assert.Equal(t, size.StarterSetProfile, size.GetAutoProfile())
+
+	// the recorded choice is kept while the capacity does not change
+	instance.Status.AutoSize.Profile = size.SmallProfile
+	require.NoError(t, b.EvaluateAutoSize(ctx, instance))
+	assert.Equal(t, size.SmallProfile, instance.Status.AutoSize.Profile)
+	assert.Equal(t, size.SmallProfile, size.GetAutoProfile())
+
+	// a new evaluation is done on request
+	instance.Annotations = map[string]string{constant.AutoSizeReevaluateAnnotation: "1"}
+	require.NoError(t, b.EvaluateAutoSize(ctx, instance))
+	assert.Equal(t, size.StarterSetProfile, instance.Status.AutoSize.Profile)
+	assert.Equal(t, "1", instance.Status.AutoSize.ObservedReevaluation)
+
+	// the choice is removed when no CommonService CR uses the auto size
+	instance.Spec.Size = size.SmallProfile
+	stored := &apiv3.CommonService{}
+	require.NoError(t, b.Client.Get(ctx, client.ObjectKeyFromObject(instance), stored))
+	stored.Spec.Size = size.SmallProfile
+	require.NoError(t, b.Client.Update(ctx, stored))
+	require.NoError(t, b.EvaluateAutoSize(ctx, instance))
+	assert.Nil(t, instance.Status.AutoSize)
+	assert.Empty(t, size.GetAutoProfile())
+}
+
+func TestRestoreAutoSize(t *testing.T) {
+	defer func() { _ = size.SetAutoProfile("") }()
+
+	b := buildTestBootstrap(t)
+	b.CSData.OperatorNs = "cs-operator"
+	ctx := context.Background()
+
+	instance := &apiv3.CommonService{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: "cs-operator"},
+		Spec:       apiv3.CommonServiceSpec{Size: size.AutoProfile},
+		Status:     apiv3.CommonServiceStatus{AutoSize: &apiv3.AutoSizeStatus{Profile: size.MediumProfile}},
+	}
+	require.NoError(t, b.Client.Create(ctx, instance))
+
+	require.NoError(t, b.RestoreAutoSize(ctx))
+	assert.Equal(t, size.MediumProfile, size.GetAutoProfile())
+}
@@ -0,0 +1,117 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// postgresClusterAPIVersions are the API versions of common-service-db, the
+// first one found is used
+var postgresClusterAPIVersions = []string{"postgresql.k8s.enterprisedb.io/v1", "pg.ibm.com/v1"}
+
+// GetPostgresCluster returns the common-service-db Cluster in the services
+// namespace, it returns nil when the Cluster is not created yet
+func (b *Bootstrap) GetPostgresCluster(ctx context.Context) (*unstructured.Unstructured, error) {
+	return b.getPostgresResource(ctx, "Cluster", constant.CSPGCluster)
+}
+
+// getPostgresResource returns the PostgreSQL resource of the kind in the
+// services namespace, it returns nil when the resource is not found
+func (b *Bootstrap) getPostgresResource(ctx context.Context, kind, name string) (*unstructured.Unstructured, error) {
+	for _, apiVersion := range postgresClusterAPIVersions {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		err := b.Reader.Get(ctx, types.NamespacedName{Name: name, Namespace: b.CSData.ServicesNs}, obj)
+		if err == nil {
+			return obj, nil
+		}
+		if !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// UpdateBackupStatus sets the backup status of the CommonService CR from the
+// status of common-service-db
+func (b *Bootstrap) UpdateBackupStatus(ctx context.Context, instance *apiv3.CommonService) error {
+	if instance.Spec.Backup == nil {
+		instance.Status.Backup = nil
+		return nil
+	}
+
+	cluster, err := b.GetPostgresCluster(ctx)
+	if err != nil {
+		return err
+	}
+	status := &apiv3.BackupStatus{}
+	if cluster != nil {
+		status.LastSuccessfulBackup, _, _ = unstructured.NestedString(cluster.Object, "status", "lastSuccessfulBackup")
+		status.LastFailedBackup, _, _ = unstructured.NestedString(cluster.Object, "status", "lastFailedBackup")
+		status.FirstRecoverabilityPoint, _, _ = unstructured.NestedString(cluster.Object, "status", "firstRecoverabilityPoint")
+	}
+
+	staleAfter := constant.DefaultBackupStaleAfter
+	if instance.Spec.Backup.StaleAfter != nil {
+		staleAfter = instance.Spec.Backup.StaleAfter.Duration
+	}
+	if status.LastSuccessfulBackup != "" {
+		lastSuccessful, err := time.Parse(time.RFC3339, status.LastSuccessfulBackup)
+		if err != nil {
+			klog.Warningf("Invalid time of the last successful backup %q: %v", status.LastSuccessfulBackup, err)
+		} else {
+			status.Stale = time.Since(lastSuccessful) > staleAfter
+		}
+	} else if cluster != nil {
+		// no backup has succeeded since the cluster was created
+		status.Stale = time.Since(cluster.GetCreationTimestamp().Time) > staleAfter
+	}
+	instance.Status.Backup = status
+	return nil
+}
+
+// checkBackupWarning sets a warning when the last successful backup is stale,
+// and clears it once a recent backup succeeds
+func (b *Bootstrap) checkBackupWarning(instance *apiv3.CommonService) {
+	instance.RemoveConditionsByReason(apiv3.ConditionReasonStaleBackup)
+	if instance.Spec.Backup == nil || instance.Status.Backup == nil || !instance.Status.Backup.Stale {
+		return
+	}
+	staleAfter := constant.DefaultBackupStaleAfter
+	if instance.Spec.Backup.StaleAfter != nil {
+		staleAfter = instance.Spec.Backup.StaleAfter.Duration
+	}
+	lastSuccessful := instance.Status.Backup.LastSuccessfulBackup
+	if lastSuccessful == "" {
+		lastSuccessful = "no backup has succeeded"
+	}
+	instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonStaleBackup, fmt.Sprintf(apiv3.ConditionMessageStaleBackup, staleAfter, lastSuccessful))
+}
@@ -0,0 +1,91 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func newPostgresCluster(t *testing.T, bs *Bootstrap, lastSuccessfulBackup string) {
+	t.Helper()
+	cluster := &unstructured.Unstructured{}
+	cluster.SetAPIVersion("pg.ibm.com/v1")
+	cluster.SetKind("Cluster")
+	cluster.SetName("common-service-db")
+	cluster.SetNamespace(bs.CSData.ServicesNs)
+	if err := unstructured.SetNestedField(cluster.Object, lastSuccessfulBackup, "status", "lastSuccessfulBackup"); err != nil {
+		t.Fatalf("failed to set the backup status: %v", err)
+	}
+	if err := bs.Client.Create(context.Background(), cluster); err != nil {
+		t.Fatalf("failed to create the cluster: %v", err)
+	}
+}
+
+func TestUpdateBackupStatus(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	lastSuccessful := time.Now().Add(-3 * time.Hour).UTC().Format(time.RFC3339)
+	newPostgresCluster(t, bs, lastSuccessful)
+
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{Backup: &apiv3.Backup{Schedule: "0 0 * * * *"}}}
+	if err := bs.UpdateBackupStatus(context.Background(), instance); err != nil {
+		t.Fatalf("UpdateBackupStatus returned error: %v", err)
+	}
+	if instance.Status.Backup == nil || instance.Status.Backup.LastSuccessfulBackup != lastSuccessful || instance.Status.Backup.Stale {
+		t.Fatalf("expected a recent backup at %s, got %+v", lastSuccessful, instance.Status.Backup)
+	}
+
+	instance.Spec.Backup.StaleAfter = &metav1.Duration{Duration: time.Hour}
+	if err := bs.UpdateBackupStatus(context.Background(), instance); err != nil {
+		t.Fatalf("UpdateBackupStatus returned error: %v", err)
+	}
+	if !instance.Status.Backup.Stale {
+		t.Fatalf("expected a stale backup, got %+v", instance.Status.Backup)
+	}
+	bs.checkBackupWarning(instance)
+	bs.checkBackupWarning(instance)
+	found := 0
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == apiv3.ConditionTypeWarning && strings.Contains(condition.Message, lastSuccessful) {
+			found++
+		}
+	}
+	if found != 1 {
+		t.Fatalf("expected one warning condition for the stale backup, got %v", instance.Status.Conditions)
+	}
+
+	instance.Status.Backup.Stale = false
+	bs.checkBackupWarning(instance)
+	if len(instance.Status.Conditions) != 0 {
+		t.Fatalf("expected the stale backup warning to be cleared, got %v", instance.Status.Conditions)
+	}
+
+	instance.Spec.Backup = nil
+	if err := bs.UpdateBackupStatus(context.Background(), instance); err != nil {
+		t.Fatalf("UpdateBackupStatus returned error: %v", err)
+	}
+	if instance.Status.Backup != nil {
+		t.Fatalf("expected no backup status without backups, got %+v", instance.Status.Backup)
+	}
+}
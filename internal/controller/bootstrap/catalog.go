@@ -0,0 +1,174 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	operatorsv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// catalogPackage is a package required from a CatalogSource, it is available
+// when one of its channels is in the CatalogSource
+type catalogPackage struct {
+	name            string
+	channels        []string
+	sourceName      string
+	sourceNamespace string
+}
+
+// ValidateCatalogs checks that the CatalogSources of ODLM and the operators in
+// the rendered OperandRegistry are READY and contain their packages and
+// channels, the issues are reported in a warning condition
+func (b *Bootstrap) ValidateCatalogs(ctx context.Context, installPlanApproval olmv1alpha1.Approval, options ...OperandRegistryOption) error {
+	b.catalogIssues = nil
+	registry, err := b.buildOperandRegistry(ctx, installPlanApproval, options...)
+	if err != nil {
+		return err
+	}
+
+	pmList := &operatorsv1.PackageManifestList{}
+	if err := b.Reader.List(ctx, pmList, client.InNamespace(b.CSData.OperatorNs)); err != nil {
+		return fmt.Errorf("failed to list PackageManifest: %v", err)
+	}
+	b.catalogIssues = validateCatalogPackages(b.catalogPackages(registry), pmList.Items, b.catalogConnectionState(ctx))
+	for _, issue := range b.catalogIssues {
+		klog.Warningf("Catalog validation failed: %s", issue)
+	}
+	return nil
+}
+
+// catalogPackages returns the packages of ODLM and the operators in the
+// OperandRegistry. ODLM is left out in the OLM v1 mode, it is installed from
+// the ClusterCatalog instead of a CatalogSource.
+func (b *Bootstrap) catalogPackages(registry *odlm.OperandRegistry) []catalogPackage {
+	var packages []catalogPackage
+	if b.InstallMode() != constant.InstallModeOLMv1 {
+		packages = append(packages, catalogPackage{
+			name:            constant.ODLMPackageName,
+			channels:        []string{constant.ODLMChannel},
+			sourceName:      b.CSData.ODLMCatalogSourceName,
+			sourceNamespace: b.CSData.ODLMCatalogSourceNs,
+		})
+	}
+	for _, operator := range registry.Spec.Operators {
+		if operator.InstallMode == "no-op" || operator.UserManaged {
+			continue
+		}
+		pkg := catalogPackage{
+			name:            operator.PackageName,
+			channels:        append([]string{operator.Channel}, operator.FallbackChannels...),
+			sourceName:      operator.SourceName,
+			sourceNamespace: operator.SourceNamespace,
+		}
+		if pkg.sourceName == "" || pkg.sourceNamespace == "" {
+			pkg.sourceName, pkg.sourceNamespace = b.CSData.CatalogSourceName, b.CSData.CatalogSourceNs
+		}
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// catalogConnectionState returns a function getting the gRPC connection state
+// of a CatalogSource. The state is empty when the CatalogSource is not
+// found, and READY when it can't be read by the operator
+func (b *Bootstrap) catalogConnectionState(ctx context.Context) func(name, namespace string) string {
+	return func(name, namespace string) string {
+		catalog := &olmv1alpha1.CatalogSource{}
+		if err := b.Reader.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, catalog); err != nil {
+			if errors.IsNotFound(err) {
+				return ""
+			}
+			klog.V(2).Infof("Skip the connection check of CatalogSource %s/%s: %v", namespace, name, err)
+			return constant.CatalogSourceReady
+		}
+		if catalog.Status.GRPCConnectionState == nil {
+			return "UNKNOWN"
+		}
+		return catalog.Status.GRPCConnectionState.LastObservedState
+	}
+}
+
+// validateCatalogPackages returns the CatalogSources that are not READY, and
+// the packages and channels missing from the READY ones
+func validateCatalogPackages(packages []catalogPackage, packageManifests []operatorsv1.PackageManifest, connectionState func(name, namespace string) string) []string {
+	var issues []string
+	catalogStates := map[string]string{}
+	for _, pkg := range packages {
+		catalog := pkg.sourceNamespace + "/" + pkg.sourceName
+		state, checked := catalogStates[catalog]
+		if !checked {
+			state = connectionState(pkg.sourceName, pkg.sourceNamespace)
+			catalogStates[catalog] = state
+			if state == "" {
+				issues = append(issues, fmt.Sprintf("CatalogSource %s is not found", catalog))
+			} else if state != constant.CatalogSourceReady {
+				issues = append(issues, fmt.Sprintf("CatalogSource %s is %s", catalog, state))
+			}
+		}
+		if state != constant.CatalogSourceReady {
+			continue
+		}
+
+		var pm *operatorsv1.PackageManifest
+		for i := range packageManifests {
+			status := packageManifests[i].Status
+			if status.PackageName == pkg.name && status.CatalogSource == pkg.sourceName && status.CatalogSourceNamespace == pkg.sourceNamespace {
+				pm = &packageManifests[i]
+				break
+			}
+		}
+		if pm == nil {
+			issues = append(issues, fmt.Sprintf("package %s is not in CatalogSource %s", pkg.name, catalog))
+			continue
+		}
+		available := false
+		for _, channel := range pm.Status.Channels {
+			if util.Contains(pkg.channels, channel.Name) {
+				available = true
+				break
+			}
+		}
+		if !available {
+			issues = append(issues, fmt.Sprintf("channels %s of package %s are not in CatalogSource %s", strings.Join(pkg.channels, ", "), pkg.name, catalog))
+		}
+	}
+	return issues
+}
+
+// checkCatalogWarning sets a warning when the CatalogSources can't install
+// the operators, and clears it once they can
+func (b *Bootstrap) checkCatalogWarning(instance *apiv3.CommonService) {
+	instance.RemoveConditionsByReason(apiv3.ConditionReasonCatalogValidation)
+	if len(b.catalogIssues) == 0 {
+		return
+	}
+	instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonCatalogValidation, fmt.Sprintf(apiv3.ConditionMessageCatalogValidation, strings.Join(b.catalogIssues, "; ")))
+}
@@ -0,0 +1,120 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	operatorsv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func newPackageManifest(name, catalog string, channels ...string) operatorsv1.PackageManifest {
+	pm := operatorsv1.PackageManifest{}
+	pm.Status.PackageName = name
+	pm.Status.CatalogSource = catalog
+	pm.Status.CatalogSourceNamespace = "openshift-marketplace"
+	for _, channel := range channels {
+		pm.Status.Channels = append(pm.Status.Channels, operatorsv1.PackageChannel{Name: channel})
+	}
+	return pm
+}
+
+func TestValidateCatalogPackages(t *testing.T) {
+	packageManifests := []operatorsv1.PackageManifest{
+		newPackageManifest(constant.ODLMPackageName, "opencloud-operators", constant.ODLMChannel),
+		newPackageManifest("ibm-iam-operator", "opencloud-operators", "v4.13"),
+		newPackageManifest("rhbk-operator", "redhat-operators", "stable-v24"),
+		newPackageManifest("ibm-zen-operator", "opencloud-operators", "v6.1"),
+	}
+	states := map[string]string{"opencloud-operators": constant.CatalogSourceReady, "redhat-operators": constant.CatalogSourceReady, "stale-operators": "TRANSIENT_FAILURE"}
+	connectionState := func(name, namespace string) string {
+		return states[name]
+	}
+
+	packages := []catalogPackage{
+		{name: constant.ODLMPackageName, channels: []string{constant.ODLMChannel}, sourceName: "opencloud-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "ibm-iam-operator", channels: []string{"v4.13"}, sourceName: "opencloud-operators", sourceNamespace: "openshift-marketplace"},
+		// the fallback channel is in the catalog
+		{name: "rhbk-operator", channels: []string{"stable-v26", "stable-v24", "stable-v22"}, sourceName: "redhat-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "ibm-zen-operator", channels: []string{"v6.2"}, sourceName: "opencloud-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "ibm-events-operator", channels: []string{"v5.1"}, sourceName: "opencloud-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "cloud-native-postgresql", channels: []string{"stable"}, sourceName: "stale-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "ibm-commonui-operator-app", channels: []string{"v4.10"}, sourceName: "stale-operators", sourceNamespace: "openshift-marketplace"},
+		{name: "ibm-platformui-operator", channels: []string{"v6.1"}, sourceName: "missing-operators", sourceNamespace: "openshift-marketplace"},
+	}
+	expected := []string{
+		"channels v6.2 of package ibm-zen-operator are not in CatalogSource openshift-marketplace/opencloud-operators",
+		"package ibm-events-operator is not in CatalogSource openshift-marketplace/opencloud-operators",
+		"CatalogSource openshift-marketplace/stale-operators is TRANSIENT_FAILURE",
+		"CatalogSource openshift-marketplace/missing-operators is not found",
+	}
+	if issues := validateCatalogPackages(packages, packageManifests, connectionState); !reflect.DeepEqual(issues, expected) {
+		t.Fatalf("expected issues %v, got %v", expected, issues)
+	}
+}
+
+func TestCheckCatalogWarning(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	instance := &apiv3.CommonService{}
+	bs.checkCatalogWarning(instance)
+	if len(instance.Status.Conditions) != 0 {
+		t.Fatalf("expected no warning without catalog issues, got %v", instance.Status.Conditions)
+	}
+
+	bs.catalogIssues = []string{"package ibm-events-operator is not in CatalogSource openshift-marketplace/opencloud-operators"}
+	bs.checkCatalogWarning(instance)
+	if len(instance.Status.Conditions) != 1 || instance.Status.Conditions[0].Reason != apiv3.ConditionReasonCatalogValidation || !strings.Contains(instance.Status.Conditions[0].Message, "ibm-events-operator") {
+		t.Fatalf("expected the catalog validation warning, got %v", instance.Status.Conditions)
+	}
+
+	bs.catalogIssues = []string{"CatalogSource openshift-marketplace/opencloud-operators is not found"}
+	bs.checkCatalogWarning(instance)
+	if len(instance.Status.Conditions) != 1 || !strings.Contains(instance.Status.Conditions[0].Message, "opencloud-operators is not found") {
+		t.Fatalf("expected only the current catalog validation warning, got %v", instance.Status.Conditions)
+	}
+
+	bs.catalogIssues = nil
+	bs.checkCatalogWarning(instance)
+	if len(instance.Status.Conditions) != 0 {
+		t.Fatalf("expected the catalog validation warning to be cleared, got %v", instance.Status.Conditions)
+	}
+}
+
+func TestCatalogPackages(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	registry := &odlm.OperandRegistry{Spec: odlm.OperandRegistrySpec{Operators: []odlm.Operator{
+		{Name: "ibm-im-operator", PackageName: "ibm-iam-operator", Channel: "v4.13"},
+		{Name: "ibm-events-operator", PackageName: "ibm-events-operator", InstallMode: "no-op"},
+	}}}
+
+	packages := bs.catalogPackages(registry)
+	if len(packages) != 2 || packages[0].name != constant.ODLMPackageName || packages[1].name != "ibm-iam-operator" {
+		t.Fatalf("expected the packages of ODLM and ibm-iam-operator, got %+v", packages)
+	}
+
+	bs.Backend = NewInstallBackend(constant.InstallModeOLMv1, bs)
+	packages = bs.catalogPackages(registry)
+	if len(packages) != 1 || packages[0].name != "ibm-iam-operator" {
+		t.Fatalf("expected only the package of ibm-iam-operator in the OLM v1 mode, got %+v", packages)
+	}
+}
@@ -0,0 +1,110 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	operatorsv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+// resolveChannels keeps the channel and the fallback channels of the operators
+// in the OperandRegistry that are available in the PackageManifests of their
+// catalogs, the first available one is the channel of the operator
+func (b *Bootstrap) resolveChannels(ctx context.Context, registry *odlm.OperandRegistry) error {
+	pmList := &operatorsv1.PackageManifestList{}
+	if err := b.Reader.List(ctx, pmList, client.InNamespace(b.CSData.OperatorNs)); err != nil {
+		return fmt.Errorf("failed to list PackageManifest: %v", err)
+	}
+
+	resolved := map[string][]string{}
+	for i := range registry.Spec.Operators {
+		operator := &registry.Spec.Operators[i]
+		if operator.InstallMode == "no-op" {
+			continue
+		}
+		sourceName, sourceNamespace := operator.SourceName, operator.SourceNamespace
+		if sourceName == "" || sourceNamespace == "" {
+			sourceName, sourceNamespace = b.CSData.CatalogSourceName, b.CSData.CatalogSourceNs
+		}
+		var pm *operatorsv1.PackageManifest
+		for j := range pmList.Items {
+			status := pmList.Items[j].Status
+			if status.PackageName == operator.PackageName && status.CatalogSource == sourceName && status.CatalogSourceNamespace == sourceNamespace {
+				pm = &pmList.Items[j]
+				break
+			}
+		}
+		if pm == nil {
+			klog.V(2).Infof("Skip the channel resolution of operator %s, package %s is not found in CatalogSource %s/%s", operator.Name, operator.PackageName, sourceNamespace, sourceName)
+			continue
+		}
+
+		channels := resolveOperatorChannels(operator, pm)
+		if len(channels) == 0 {
+			klog.Warningf("None of the channels %v of operator %s is in CatalogSource %s/%s", append([]string{operator.Channel}, operator.FallbackChannels...), operator.Name, sourceNamespace, sourceName)
+			continue
+		}
+		if operator.Channel == "" {
+			klog.Infof("Using default channel %s of package %s for operator %s", channels[0], operator.PackageName, operator.Name)
+		} else if channels[0] != operator.Channel {
+			klog.Infof("Channel %s of operator %s is not in CatalogSource %s/%s, using channel %s", operator.Channel, operator.Name, sourceNamespace, sourceName, channels[0])
+		}
+		operator.Channel = channels[0]
+		operator.FallbackChannels = channels[1:]
+		resolved[operator.Name] = channels
+	}
+	b.resolvedChannels = resolved
+	return nil
+}
+
+// resolveOperatorChannels returns the channel and the fallback channels of the
+// operator in their order that are available in the PackageManifest. The
+// default channel of the package is used for an operator without channels
+func resolveOperatorChannels(operator *odlm.Operator, pm *operatorsv1.PackageManifest) []string {
+	var available []string
+	for _, channel := range pm.Status.Channels {
+		available = append(available, channel.Name)
+	}
+	if operator.Channel == "" && len(operator.FallbackChannels) == 0 {
+		if pm.Status.DefaultChannel == "" {
+			return nil
+		}
+		return []string{pm.Status.DefaultChannel}
+	}
+
+	channels := []string{}
+	for _, channel := range append([]string{operator.Channel}, operator.FallbackChannels...) {
+		if channel != "" && util.Contains(available, channel) && !util.Contains(channels, channel) {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// ResolvedChannels returns the channel and the fallback channels of an
+// operator resolved from its PackageManifest, it is empty when the channels
+// are not resolved
+func (b *Bootstrap) ResolvedChannels(operatorName string) []string {
+	return b.resolvedChannels[operatorName]
+}
@@ -0,0 +1,87 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	operatorsv1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResolveChannels(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := operatorsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add packagemanifests to the scheme: %v", err)
+	}
+	bs.CSData.OperatorNs = bs.CSData.CPFSNs
+	bs.CSData.CatalogSourceName = "opencloud-operators"
+	bs.CSData.CatalogSourceNs = "openshift-marketplace"
+
+	keycloak := newPackageManifest("rhbk-operator", "redhat-operators", "stable-v24", "stable-v22")
+	iam := newPackageManifest("ibm-iam-operator", "opencloud-operators", "v4.12", "v4.13")
+	zen := newPackageManifest("ibm-zen-operator", "opencloud-operators", "v6.1")
+	events := newPackageManifest("ibm-events-operator", "opencloud-operators", "v5.0", "v5.1")
+	events.Status.DefaultChannel = "v5.1"
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, pm := range []operatorsv1.PackageManifest{keycloak, iam, zen, events} {
+		pm := pm
+		pm.Name = pm.Status.PackageName
+		pm.Namespace = bs.CSData.OperatorNs
+		builder = builder.WithObjects(&pm)
+	}
+	bs.Client = builder.Build()
+	bs.Reader = bs.Client
+
+	registry := &odlm.OperandRegistry{Spec: odlm.OperandRegistrySpec{Operators: []odlm.Operator{
+		{Name: "keycloak-operator", PackageName: "rhbk-operator", Channel: "stable-v26", FallbackChannels: []string{"stable-v24", "stable-v22"}, SourceName: "redhat-operators", SourceNamespace: "openshift-marketplace"},
+		{Name: "ibm-im-operator", PackageName: "ibm-iam-operator", Channel: "v4.13"},
+		{Name: "ibm-platformui-operator", PackageName: "ibm-zen-operator", Channel: "v6.2"},
+		{Name: "ibm-events-operator", PackageName: "ibm-events-operator"},
+		{Name: "ibm-mgmt-ui-operator", PackageName: "ibm-commonui-operator-app", Channel: "v4.10"},
+	}}}
+	if err := bs.resolveChannels(context.Background(), registry); err != nil {
+		t.Fatalf("resolveChannels returned error: %v", err)
+	}
+
+	expected := map[string][]string{
+		// the first available channel is chosen, the fallback channels keep their order
+		"keycloak-operator": {"stable-v24", "stable-v22"},
+		"ibm-im-operator":   {"v4.13"},
+		// no channel is available, the channel is not changed
+		"ibm-platformui-operator": {"v6.2"},
+		// the default channel of the package is used without channels
+		"ibm-events-operator": {"v5.1"},
+		// the package is not in the catalog
+		"ibm-mgmt-ui-operator": {"v4.10"},
+	}
+	for _, operator := range registry.Spec.Operators {
+		if channels := append([]string{operator.Channel}, operator.FallbackChannels...); !reflect.DeepEqual(channels, expected[operator.Name]) {
+			t.Errorf("expected channels %v of operator %s, got %v", expected[operator.Name], operator.Name, channels)
+		}
+	}
+	if channels := bs.ResolvedChannels("keycloak-operator"); !reflect.DeepEqual(channels, []string{"stable-v24", "stable-v22"}) {
+		t.Errorf("expected the resolved channels of keycloak-operator, got %v", channels)
+	}
+	if channels := bs.ResolvedChannels("ibm-platformui-operator"); len(channels) != 0 {
+		t.Errorf("expected no resolved channels of ibm-platformui-operator, got %v", channels)
+	}
+}
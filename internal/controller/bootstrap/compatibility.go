@@ -0,0 +1,151 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	utilyaml "github.com/ghodss/yaml"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/version"
+)
+
+// compatibilityMatrixYAML is the supported versions of the operators for each
+// version of IBM Common Service Operator
+//
+//go:embed compatibility.yaml
+var compatibilityMatrixYAML []byte
+
+// operatorCompatibility is the supported versions of an operator
+type operatorCompatibility struct {
+	Versions string `json:"versions"`
+	Channel  string `json:"channel"`
+}
+
+// compatibleOperators returns the supported versions of the operators for a
+// version of IBM Common Service Operator, it is empty when the version is not
+// in the matrix
+func compatibleOperators(csVersion string) (map[string]operatorCompatibility, error) {
+	matrix := map[string]map[string]operatorCompatibility{}
+	if err := utilyaml.Unmarshal(compatibilityMatrixYAML, &matrix); err != nil {
+		return nil, fmt.Errorf("invalid compatibility matrix: %v", err)
+	}
+	return matrix[csVersion], nil
+}
+
+// versionSkew returns the installed operators whose versions are not
+// supported, with the channels to move to. The operators that are not named
+// after their CSVs are looked up by their package names.
+func versionSkew(operators []apiv3.BedrockOperator, packages map[string]string, compatible map[string]operatorCompatibility) ([]string, error) {
+	var skew []string
+	for _, operator := range operators {
+		supported, ok := compatible[operator.Name]
+		if !ok {
+			supported, ok = compatible[packages[operator.Name]]
+		}
+		if !ok || operator.Version == "" {
+			continue
+		}
+		versions, err := semver.ParseRange(supported.Versions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid versions %q of operator %s in the compatibility matrix: %v", supported.Versions, operator.Name, err)
+		}
+		installed, err := semver.ParseTolerant(operator.Version)
+		if err != nil {
+			klog.V(2).Infof("Skip the version skew check of operator %s, its version %s is invalid: %v", operator.Name, operator.Version, err)
+			continue
+		}
+		if !versions(installed) {
+			skew = append(skew, fmt.Sprintf("%s %s, move to channel %s", operator.Name, operator.Version, supported.Channel))
+		}
+	}
+	sort.Strings(skew)
+	return skew, nil
+}
+
+// checkVersionSkewWarning sets a warning when the installed operators are not
+// a supported combination for this version of IBM Common Service Operator
+func (b *Bootstrap) checkVersionSkewWarning(instance *apiv3.CommonService) {
+	instance.RemoveConditionsByReason(apiv3.ConditionReasonVersionSkew)
+	compatible, err := compatibleOperators(version.Version)
+	if err != nil {
+		klog.Warning(err)
+		return
+	}
+	if len(compatible) == 0 {
+		klog.V(2).Infof("Version %s is not in the compatibility matrix, skipping the version skew check", version.Version)
+		return
+	}
+
+	operators := instance.Status.BedrockOperators
+	// ODLM is installed by this operator, it is not in the OperandRegistry
+	if odlm := b.installedODLM(); odlm != nil {
+		operators = append([]apiv3.BedrockOperator{*odlm}, operators...)
+	}
+	skew, err := versionSkew(operators, b.operatorPackages(), compatible)
+	if err != nil {
+		klog.Warning(err)
+		return
+	}
+	if len(skew) > 0 {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonVersionSkew, fmt.Sprintf(apiv3.ConditionMessageVersionSkew, version.Version, strings.Join(skew, "; ")))
+	}
+}
+
+// operatorPackages returns the package names of the operators in the
+// OperandRegistry, the operators installed without OLM or by OLM v1 are
+// reported under their names in the OperandRegistry
+func (b *Bootstrap) operatorPackages() map[string]string {
+	packages := map[string]string{}
+	registry, err := b.GetOperandRegistry(ctx, constant.MasterCR, b.CSData.ServicesNs)
+	if err != nil {
+		klog.V(2).Infof("Failed to get the OperandRegistry, checking the version skew by operator names: %v", err)
+		return packages
+	}
+	for _, operator := range registry.Spec.Operators {
+		packages[operator.Name] = operator.PackageName
+	}
+	return packages
+}
+
+// installedODLM returns the name and the version of the installed ODLM CSV,
+// it is nil when ODLM is not installed by OLM
+func (b *Bootstrap) installedODLM() *apiv3.BedrockOperator {
+	sub := &olmv1alpha1.Subscription{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: constant.IBMODLMPackage, Namespace: b.CSData.CPFSNs}, sub); err != nil {
+		klog.V(2).Infof("Failed to get the ODLM Subscription, skipping its version skew check: %v", err)
+		return nil
+	}
+	installedCSV := sub.Status.InstalledCSV
+	if !strings.Contains(installedCSV, ".") {
+		return nil
+	}
+	return &apiv3.BedrockOperator{
+		Name:    installedCSV[:strings.IndexByte(installedCSV, '.')],
+		Version: installedCSV[strings.IndexByte(installedCSV, '.')+1:],
+	}
+}
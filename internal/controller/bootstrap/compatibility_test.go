@@ -0,0 +1,106 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"github.com/blang/semver/v4"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/version"
+)
+
+func TestCompatibilityMatrix(t *testing.T) {
+	compatible, err := compatibleOperators(version.Version)
+	if err != nil {
+		t.Fatalf("failed to load the compatibility matrix: %v", err)
+	}
+	if len(compatible) == 0 {
+		t.Fatalf("expected version %s in the compatibility matrix", version.Version)
+	}
+	for name, supported := range compatible {
+		if _, err := semver.ParseRange(supported.Versions); err != nil {
+			t.Errorf("invalid versions %q of operator %s: %v", supported.Versions, name, err)
+		}
+		if supported.Channel == "" {
+			t.Errorf("expected a channel for operator %s", name)
+		}
+	}
+	if compatible["operand-deployment-lifecycle-manager"].Channel != constant.ODLMChannel {
+		t.Errorf("expected the ODLM channel %s, got %s", constant.ODLMChannel, compatible["operand-deployment-lifecycle-manager"].Channel)
+	}
+}
+
+func TestCheckVersionSkewWarning(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := olmv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add operators/v1alpha1 to the scheme: %v", err)
+	}
+	sub := &olmv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.IBMODLMPackage, Namespace: bs.CSData.CPFSNs},
+		Status:     olmv1alpha1.SubscriptionStatus{InstalledCSV: "operand-deployment-lifecycle-manager.v4.4.2"},
+	}
+	registry := &odlm.OperandRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs},
+		Spec: odlm.OperandRegistrySpec{Operators: []odlm.Operator{
+			{Name: "keycloak-operator", PackageName: "rhbk-operator"},
+		}},
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(sub, registry).Build()
+	bs.Reader = bs.Client
+
+	instance := &apiv3.CommonService{Status: apiv3.CommonServiceStatus{BedrockOperators: []apiv3.BedrockOperator{
+		{Name: "ibm-iam-operator", Version: "v4.18.1"},
+		{Name: "cloud-native-postgresql", Version: "v1.21.0"},
+		{Name: "ibm-events-operator", Version: "v5.1.0"},
+		{Name: "keycloak-operator", Version: "21.1.0"},
+	}}}
+	bs.checkVersionSkewWarning(instance)
+	bs.checkVersionSkewWarning(instance)
+	if len(instance.Status.Conditions) != 1 {
+		t.Fatalf("expected a version skew warning, got %v", instance.Status.Conditions)
+	}
+	condition := instance.Status.Conditions[0]
+	if condition.Type != apiv3.ConditionTypeWarning || condition.Reason != apiv3.ConditionReasonVersionSkew {
+		t.Fatalf("expected a VersionSkew warning, got %+v", condition)
+	}
+	for _, expected := range []string{"cloud-native-postgresql v1.21.0, move to channel stable-v1.25", "operand-deployment-lifecycle-manager v4.4.2, move to channel v4.5",
+		"keycloak-operator 21.1.0, move to channel stable-v26"} {
+		if !strings.Contains(condition.Message, expected) {
+			t.Errorf("expected %q in the warning, got %s", expected, condition.Message)
+		}
+	}
+	if strings.Contains(condition.Message, "ibm-iam-operator") || strings.Contains(condition.Message, "ibm-events-operator") {
+		t.Errorf("expected only the unsupported operators in the warning, got %s", condition.Message)
+	}
+
+	instance.Status.BedrockOperators = instance.Status.BedrockOperators[:1]
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(registry).Build()
+	bs.Reader = bs.Client
+	bs.checkVersionSkewWarning(instance)
+	if len(instance.Status.Conditions) != 0 {
+		t.Fatalf("expected the version skew warning to be cleared, got %v", instance.Status.Conditions)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
 	"k8s.io/klog"
 )
 
@@ -87,14 +88,6 @@ func (b *Bootstrap) getLargestSizeFromAllCRs(ctx context.Context) (string, error
 		return "", err
 	}
 
-	sizePriority := map[string]int{
-		"starterset": 0,
-		"starter":    0,
-		"small":      1,
-		"medium":     2,
-		"large":      3,
-	}
-
 	largestSize := ""
 	largestPriority := -1
 
@@ -104,7 +97,7 @@ func (b *Bootstrap) getLargestSizeFromAllCRs(ctx context.Context) (string, error
 		}
 
 		csSize := cs.Spec.Size
-		if priority, ok := sizePriority[csSize]; ok {
+		if priority, ok := size.Priority(csSize); ok {
 			klog.Infof("Bootstrap: CommonService CR %s/%s has size: %s (priority: %d)", cs.Namespace, cs.Name, csSize, priority)
 			if priority > largestPriority {
 				largestPriority = priority
@@ -112,13 +105,13 @@ func (b *Bootstrap) getLargestSizeFromAllCRs(ctx context.Context) (string, error
 				klog.Infof("Bootstrap: New largest size found: %s (priority: %d)", largestSize, largestPriority)
 			}
 		} else if csSize != "" {
-			klog.Infof("Bootstrap: CommonService CR %s/%s has custom size configuration (not a predefined size)", cs.Namespace, cs.Name)
+			klog.Infof("Bootstrap: CommonService CR %s/%s has custom size configuration (not a known size profile)", cs.Namespace, cs.Name)
 		}
 	}
 
 	if largestSize == "" {
-		klog.Info("Bootstrap: No predefined size found in any CommonService CR, will use starterset")
-		return "starterset", nil
+		klog.Info("Bootstrap: No known size profile found in any CommonService CR, will use starterset")
+		return size.StarterSetProfile, nil
 	}
 
 	klog.Infof("Bootstrap: FINAL DECISION - Largest size across all CommonService CRs is: %s (priority: %d)", largestSize, largestPriority)
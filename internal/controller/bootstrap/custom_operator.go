@@ -0,0 +1,177 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	utilyaml "github.com/ghodss/yaml"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// LoadCustomOperators loads the custom operators of the CommonService CR in
+// the operator namespace. Each custom operator is added to the OperandRegistry
+// and the OperandConfig after the template overlays. A custom operator that
+// conflicts with an operator of the OperandRegistry or a service of the
+// OperandConfig is skipped as a whole with a warning.
+func (b *Bootstrap) LoadCustomOperators(ctx context.Context) error {
+	b.customOperators = nil
+	cs := &apiv3.CommonService{}
+	if err := b.Client.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: b.CSData.OperatorNs}, cs); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get CommonService %s/%s: %v", b.CSData.OperatorNs, constant.MasterCR, err)
+	}
+	if len(cs.Spec.CustomOperators) == 0 {
+		return nil
+	}
+
+	// the custom operators are validated against the rendered templates
+	registry, err := b.renderOperandRegistry(ctx)
+	if err != nil {
+		return err
+	}
+	services, err := b.renderConfigServices()
+	if err != nil {
+		return err
+	}
+	operators := registry.Spec.Operators
+
+	for _, operator := range cs.Spec.CustomOperators {
+		overlay, registryOperator, err := b.customOperatorOverlay(cs, operator)
+		if err == nil {
+			err = validateCustomOperator(registryOperator, operators, services)
+			if err != nil {
+				err = fmt.Errorf("%s: %v", overlay.source, err)
+			}
+		}
+		if err != nil {
+			b.addTemplateOverlayErr(err)
+			continue
+		}
+		// the next custom operators can't conflict with this one
+		operators = append(operators, registryOperator)
+		services = append(services, odlm.ConfigService{Name: operator.Name})
+		b.customOperators = append(b.customOperators, overlay)
+	}
+	klog.V(2).Infof("Loaded %d custom operators from CommonService %s/%s", len(b.customOperators), cs.Namespace, cs.Name)
+	return nil
+}
+
+// renderConfigServices returns the services of the OperandConfig rendered
+// from the templates, with the template overlays and the custom operators
+func (b *Bootstrap) renderConfigServices() ([]odlm.ConfigService, error) {
+	opcon, err := constant.ConcatenateConfigs(constant.CSV4OpCon, util.GetBaseOperandConfigList(), b.CSData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to concatenate OperandConfig: %v", err)
+	}
+	if opcon, err = b.ApplyOperandConfigOverlays(opcon); err != nil {
+		return nil, err
+	}
+	config := &odlm.OperandConfig{}
+	if err := utilyaml.Unmarshal([]byte(opcon), config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OperandConfig template: %v", err)
+	}
+	return config.Spec.Services, nil
+}
+
+// validateCustomOperator checks that a custom operator doesn't replace an
+// operator of the OperandRegistry or a service of the OperandConfig, and that
+// its package isn't already installed into the same namespace
+func validateCustomOperator(custom odlm.Operator, operators []odlm.Operator, services []odlm.ConfigService) error {
+	for _, operator := range operators {
+		if operator.Name == custom.Name {
+			return fmt.Errorf("operator %s already exists in the OperandRegistry", custom.Name)
+		}
+		if operator.PackageName == custom.PackageName && operator.Namespace == custom.Namespace {
+			return fmt.Errorf("package %s in namespace %s is already installed by operator %s", custom.PackageName, custom.Namespace, operator.Name)
+		}
+	}
+	for _, service := range services {
+		if service.Name == custom.Name {
+			return fmt.Errorf("service %s already exists in the OperandConfig", custom.Name)
+		}
+	}
+	return nil
+}
+
+// customOperatorOverlay converts a custom operator into an overlay adding the
+// operator to the OperandRegistry, and its defaults to the OperandConfig. It
+// also returns the operator added to the OperandRegistry.
+func (b *Bootstrap) customOperatorOverlay(cs *apiv3.CommonService, operator apiv3.CustomOperator) (templateOverlay, odlm.Operator, error) {
+	overlay := templateOverlay{source: fmt.Sprintf("custom operator %s of CommonService %s/%s", operator.Name, cs.Namespace, cs.Name)}
+
+	registryOperator := odlm.Operator{
+		Name:                operator.Name,
+		Namespace:           operator.Namespace,
+		Channel:             operator.Channel,
+		FallbackChannels:    operator.FallbackChannels,
+		PackageName:         operator.PackageName,
+		Scope:               odlm.ScopePublic,
+		InstallMode:         operator.InstallMode,
+		InstallPlanApproval: olmv1alpha1.Approval(b.CSData.ApprovalMode),
+		SourceName:          operator.SourceName,
+		SourceNamespace:     operator.SourceNamespace,
+	}
+	if registryOperator.Namespace == "" {
+		registryOperator.Namespace = b.CSData.CPFSNs
+	}
+	if operator.Scope == string(odlm.ScopePrivate) {
+		registryOperator.Scope = odlm.ScopePrivate
+	}
+	if registryOperator.SourceName == "" || registryOperator.SourceNamespace == "" {
+		registryOperator.SourceName, registryOperator.SourceNamespace = b.CSData.CatalogSourceName, b.CSData.CatalogSourceNs
+	}
+	items, err := toItems([]odlm.Operator{registryOperator})
+	if err != nil {
+		return overlay, registryOperator, fmt.Errorf("%s: %v", overlay.source, err)
+	}
+	overlay.registry.Add = items
+
+	if len(operator.Spec) > 0 || len(operator.Resources) > 0 {
+		service := map[string]interface{}{
+			"name":      operator.Name,
+			"spec":      operator.Spec,
+			"resources": operator.Resources,
+		}
+		raw, err := json.Marshal(service)
+		if err == nil {
+			service = map[string]interface{}{}
+			err = json.Unmarshal(raw, &service)
+		}
+		if err != nil {
+			return overlay, registryOperator, fmt.Errorf("%s: %v", overlay.source, err)
+		}
+		overlay.config.Add = []map[string]interface{}{service}
+	}
+
+	if err := overlay.validate(); err != nil {
+		return overlay, registryOperator, fmt.Errorf("%s: %v", overlay.source, err)
+	}
+	return overlay, registryOperator, nil
+}
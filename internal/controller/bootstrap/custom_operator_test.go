@@ -0,0 +1,152 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	utilyaml "github.com/ghodss/yaml"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func TestLoadCustomOperators(t *testing.T) {
+	bs := buildOverlayBootstrap(t)
+	ctx := context.Background()
+
+	cs := &apiv3.CommonService{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: "ibm-common-services"},
+		Spec: apiv3.CommonServiceSpec{
+			CustomOperators: []apiv3.CustomOperator{
+				{
+					Name:        "site-operator",
+					PackageName: "site-operator-app",
+					Channel:     "v1",
+					Scope:       "private",
+					Spec: map[string]apiv3.ExtensionWithMarker{
+						"siteOperand": {RawExtension: runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}},
+					},
+				},
+				{
+					Name:        "ibm-licensing-operator",
+					PackageName: "other-licensing-operator",
+				},
+				{
+					Name:        "invalid-operator",
+					PackageName: "invalid-operator",
+					Resources: []apiv3.ExtensionWithMarker{
+						{RawExtension: runtime.RawExtension{Raw: []byte(`{"name":"config","apiVersion":"v1"}`)}},
+					},
+				},
+				{
+					Name:        "ibm-redis-cp-operator",
+					PackageName: "other-redis-cp",
+					Spec: map[string]apiv3.ExtensionWithMarker{
+						"redis": {RawExtension: runtime.RawExtension{Raw: []byte(`{"replicas":5}`)}},
+					},
+				},
+				{
+					Name:        "redis-copy-operator",
+					PackageName: "ibm-redis-cp",
+				},
+			},
+		},
+	}
+	if err := bs.Client.Create(ctx, cs); err != nil {
+		t.Fatalf("failed to create CommonService: %v", err)
+	}
+
+	if err := bs.LoadCustomOperators(ctx); err != nil {
+		t.Fatalf("LoadCustomOperators returned error: %v", err)
+	}
+	if len(bs.customOperators) != 1 {
+		t.Fatalf("expected 1 custom operator to be loaded, got %d", len(bs.customOperators))
+	}
+	registry, err := bs.buildOperandRegistry(ctx, olmv1alpha1.ApprovalAutomatic)
+	if err != nil {
+		t.Fatalf("buildOperandRegistry returned error: %v", err)
+	}
+
+	added := findOperator(registry.Spec.Operators, "site-operator")
+	if added == nil {
+		t.Fatalf("expected site-operator to be added to the OperandRegistry")
+	}
+	if added.Namespace != bs.CSData.CPFSNs || added.SourceName != bs.CSData.CatalogSourceName || added.SourceNamespace != bs.CSData.CatalogSourceNs {
+		t.Errorf("expected the defaults of site-operator, got %+v", added)
+	}
+	if added.Scope != odlm.ScopePrivate {
+		t.Errorf("expected site-operator to be private, got %s", added.Scope)
+	}
+	licensing := findOperator(registry.Spec.Operators, "ibm-licensing-operator")
+	if licensing == nil || licensing.PackageName != "ibm-licensing-operator-app" {
+		t.Errorf("expected the built-in ibm-licensing-operator to be kept, got %+v", licensing)
+	}
+
+	if findOperator(registry.Spec.Operators, "redis-copy-operator") != nil {
+		t.Errorf("expected redis-copy-operator not to be added to the OperandRegistry")
+	}
+
+	expectedErrs := []string{
+		"operator ibm-licensing-operator already exists in the OperandRegistry",
+		"custom operator invalid-operator",
+		"operator ibm-redis-cp-operator already exists in the OperandRegistry",
+		"package ibm-redis-cp in namespace " + bs.CSData.CPFSNs + " is already installed by operator ibm-redis-cp-operator",
+	}
+	if len(bs.templateOverlayErrs) != len(expectedErrs) {
+		t.Fatalf("expected %d errors, got %v", len(expectedErrs), bs.templateOverlayErrs)
+	}
+	for i, expected := range expectedErrs {
+		if !strings.Contains(bs.templateOverlayErrs[i].Error(), expected) {
+			t.Errorf("expected error %q, got %v", expected, bs.templateOverlayErrs[i])
+		}
+	}
+
+	rendered, err := constant.RenderTemplate(constant.CSV4OpCon, bs.CSData)
+	if err != nil {
+		t.Fatalf("failed to render OperandConfig: %v", err)
+	}
+	opcon, err := bs.ApplyOperandConfigOverlays(string(rendered))
+	if err != nil {
+		t.Fatalf("ApplyOperandConfigOverlays returned error: %v", err)
+	}
+	config := &odlm.OperandConfig{}
+	if err := utilyaml.Unmarshal([]byte(opcon), config); err != nil {
+		t.Fatalf("failed to unmarshal OperandConfig: %v", err)
+	}
+	for _, service := range config.Spec.Services {
+		// the defaults of a rejected custom operator are not merged
+		if service.Name == "ibm-redis-cp-operator" {
+			t.Fatalf("expected ibm-redis-cp-operator not to be added to the OperandConfig")
+		}
+	}
+	for _, service := range config.Spec.Services {
+		if service.Name == "site-operator" {
+			if string(service.Spec["siteOperand"].Raw) != `{"replicas":2}` {
+				t.Errorf("unexpected defaults of site-operator: %s", service.Spec["siteOperand"].Raw)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected site-operator to be added to the OperandConfig")
+}
@@ -0,0 +1,120 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// dbConsumers are the Deployments connecting to common-service-db, they are
+// scaled down in order while the database is restored or is a replica. The
+// operators are scaled down first, so they don't scale their operands up again.
+var dbConsumers = []struct {
+	name     string
+	operator bool
+}{
+	{name: "ibm-iam-operator", operator: true},
+	{name: "platform-auth-service"},
+	{name: "platform-identity-provider"},
+	{name: "platform-identity-management"},
+}
+
+// dbConsumerKey returns the namespaced name of a consumer of
+// common-service-db
+func (b *Bootstrap) dbConsumerKey(name string, operator bool) types.NamespacedName {
+	if operator {
+		return types.NamespacedName{Name: name, Namespace: b.CSData.CPFSNs}
+	}
+	return types.NamespacedName{Name: name, Namespace: b.CSData.ServicesNs}
+}
+
+// scaleDownDBConsumers scales the consumers of common-service-db to zero,
+// their replicas are kept in an annotation. It returns true when all their
+// pods are gone.
+func (b *Bootstrap) scaleDownDBConsumers(ctx context.Context) (bool, error) {
+	scaledDown := true
+	for _, consumer := range dbConsumers {
+		deploy := &appsv1.Deployment{}
+		if err := b.Reader.Get(ctx, b.dbConsumerKey(consumer.name, consumer.operator), deploy); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if deploy.Spec.Replicas == nil || *deploy.Spec.Replicas != 0 {
+			replicas := int32(1)
+			if deploy.Spec.Replicas != nil {
+				replicas = *deploy.Spec.Replicas
+			}
+			if deploy.Annotations == nil {
+				deploy.Annotations = map[string]string{}
+			}
+			if _, ok := deploy.Annotations[constant.DBConsumerReplicasAnno]; !ok {
+				deploy.Annotations[constant.DBConsumerReplicasAnno] = strconv.Itoa(int(replicas))
+			}
+			zero := int32(0)
+			deploy.Spec.Replicas = &zero
+			klog.Infof("Scaling down Deployment %s/%s connecting to %s", deploy.Namespace, deploy.Name, constant.CSPGCluster)
+			if err := b.Client.Update(ctx, deploy); err != nil {
+				return false, err
+			}
+		}
+		if deploy.Status.Replicas > 0 {
+			scaledDown = false
+		}
+	}
+	return scaledDown, nil
+}
+
+// scaleUpDBConsumers scales the consumers of common-service-db back to the
+// replicas they had before they were scaled down
+func (b *Bootstrap) scaleUpDBConsumers(ctx context.Context) error {
+	for _, consumer := range dbConsumers {
+		deploy := &appsv1.Deployment{}
+		if err := b.Reader.Get(ctx, b.dbConsumerKey(consumer.name, consumer.operator), deploy); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		value, ok := deploy.Annotations[constant.DBConsumerReplicasAnno]
+		if !ok {
+			continue
+		}
+		replicas, err := strconv.Atoi(value)
+		if err != nil {
+			klog.Warningf("Invalid replicas %q of Deployment %s/%s before it was scaled down, scaling it to 1: %v", value, deploy.Namespace, deploy.Name, err)
+			replicas = 1
+		}
+		scaled := int32(replicas)
+		deploy.Spec.Replicas = &scaled
+		delete(deploy.Annotations, constant.DBConsumerReplicasAnno)
+		klog.Infof("Scaling up Deployment %s/%s connecting to %s to %d replicas", deploy.Namespace, deploy.Name, constant.CSPGCluster, replicas)
+		if err := b.Client.Update(ctx, deploy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// setDeploymentStatus reports the status of an operator deployed without OLM
+// from its Deployment and the pods of the Deployment
+func (b *Bootstrap) setDeploymentStatus(ctx context.Context, instance *apiv3.CommonService, name, namespace string) (apiv3.BedrockOperator, error) {
+	opt := apiv3.BedrockOperator{Name: name}
+	if namespace == "" {
+		namespace = b.CSData.CPFSNs
+	}
+
+	deploy, err := b.fetchOperatorDeployment(ctx, name, namespace)
+	if err != nil {
+		klog.Errorf("Failed to get Deployment of operator %s in namespace %s: %v", name, namespace, err)
+		return opt, err
+	}
+	if deploy == nil {
+		klog.Warningf("Failed to find Deployment of operator %s in namespace %s", name, namespace)
+		opt.OperatorStatus = apiv3.CRNotReady
+		opt.Troubleshooting = fmt.Sprintf("Deployment of operator %s is not found in namespace %s, please check if it is installed by its helm chart", name, namespace)
+		return opt, nil
+	}
+	opt.Version = deploymentVersion(deploy)
+
+	crashLooping, err := b.crashLoopingPods(ctx, deploy)
+	if err != nil {
+		klog.Errorf("Failed to list pods of Deployment %s/%s: %v", deploy.Namespace, deploy.Name, err)
+		return opt, err
+	}
+
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	switch {
+	case len(crashLooping) > 0:
+		opt.OperatorStatus = apiv3.CRFailed
+		opt.Troubleshooting = fmt.Sprintf("Pods %s of Deployment %s/%s are crash looping, please check their logs and %s for more information", strings.Join(crashLooping, ", "), deploy.Namespace, deploy.Name, constant.GeneralTroubleshooting)
+	case rolloutDeadlineExceeded(deploy):
+		opt.OperatorStatus = apiv3.CRFailed
+		opt.Troubleshooting = fmt.Sprintf("Rollout of Deployment %s/%s exceeded its progress deadline, please check %s for more information", deploy.Namespace, deploy.Name, constant.GeneralTroubleshooting)
+	case replicas == 0:
+		opt.OperatorStatus = apiv3.CRNotReady
+		opt.Troubleshooting = fmt.Sprintf("Deployment %s/%s is scaled to zero replicas", deploy.Namespace, deploy.Name)
+	case deploy.Status.ObservedGeneration < deploy.Generation || deploy.Status.UpdatedReplicas < replicas || deploy.Status.Replicas > deploy.Status.UpdatedReplicas:
+		opt.OperatorStatus = apiv3.CRUpdating
+		opt.Troubleshooting = fmt.Sprintf("Deployment %s/%s is rolling out, %d of %d replicas are updated", deploy.Namespace, deploy.Name, deploy.Status.UpdatedReplicas, replicas)
+	case deploy.Status.AvailableReplicas < replicas:
+		opt.OperatorStatus = apiv3.CRNotReady
+		opt.Troubleshooting = fmt.Sprintf("Deployment %s/%s has %d of %d replicas available, please check %s for more information", deploy.Namespace, deploy.Name, deploy.Status.AvailableReplicas, replicas, constant.GeneralTroubleshooting)
+	default:
+		opt.OperatorStatus = apiv3.CRSucceeded
+	}
+
+	if opt.OperatorStatus != apiv3.CRSucceeded {
+		b.EventRecorder.Eventf(instance, "Warning", "Bedrock Operator Failed", "Deployment %s/%s is not healthy, please check troubleshooting document %s for reasons and solutions", deploy.Namespace, deploy.Name, constant.GeneralTroubleshooting)
+	}
+	return opt, nil
+}
+
+// fetchOperatorDeployment returns the Deployment of an operator by its name,
+// or by the label app.kubernetes.io/name of the helm charts. It returns nil
+// when it is not found
+func (b *Bootstrap) fetchOperatorDeployment(ctx context.Context, name, namespace string) (*appsv1.Deployment, error) {
+	deploy := &appsv1.Deployment{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, deploy); err == nil {
+		return deploy, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	deployList := &appsv1.DeploymentList{}
+	if err := b.Reader.List(ctx, deployList, client.InNamespace(namespace), client.MatchingLabels{"app.kubernetes.io/name": name}); err != nil {
+		return nil, err
+	}
+	if len(deployList.Items) > 1 {
+		return nil, fmt.Errorf("multiple Deployments found by label app.kubernetes.io/name=%s in namespace %s", name, namespace)
+	} else if len(deployList.Items) == 0 {
+		return nil, nil
+	}
+	return &deployList.Items[0], nil
+}
+
+// crashLoopingPods returns the names of the pods of the Deployment with a
+// container in CrashLoopBackOff
+func (b *Bootstrap) crashLoopingPods(ctx context.Context, deploy *appsv1.Deployment) ([]string, error) {
+	if deploy.Spec.Selector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	if err := b.Reader.List(ctx, podList, client.InNamespace(deploy.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var pods []string
+	for _, pod := range podList.Items {
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+				pods = append(pods, pod.Name)
+				break
+			}
+		}
+	}
+	return pods, nil
+}
+
+// rolloutDeadlineExceeded returns true when the rollout of the Deployment
+// didn't make progress within its progress deadline
+func rolloutDeadlineExceeded(deploy *appsv1.Deployment) bool {
+	for _, condition := range deploy.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing {
+			return condition.Status == corev1.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded"
+		}
+	}
+	return false
+}
+
+// deploymentVersion returns the version of an operator from the label
+// app.kubernetes.io/version of its Deployment, or from the image tag of its
+// first container
+func deploymentVersion(deploy *appsv1.Deployment) string {
+	version := deploy.Labels["app.kubernetes.io/version"]
+	if version == "" {
+		version = deploy.Spec.Template.Labels["app.kubernetes.io/version"]
+	}
+	if version == "" && len(deploy.Spec.Template.Spec.Containers) > 0 {
+		image := deploy.Spec.Template.Spec.Containers[0].Image
+		// the tag is ignored when the image is pinned by its digest
+		if !strings.Contains(image, "@") {
+			if i := strings.LastIndexByte(image, ':'); i > strings.LastIndexByte(image, '/') {
+				version = image[i+1:]
+			}
+		}
+	}
+	// align with the versions of the CSVs
+	if version != "" && version[0] >= '0' && version[0] <= '9' {
+		version = "v" + version
+	}
+	return version
+}
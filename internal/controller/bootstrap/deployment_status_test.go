@@ -0,0 +1,102 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func newOperatorDeployment(name, namespace, image string, replicas int32) *appsv1.Deployment {
+	labels := map[string]string{"app.kubernetes.io/name": name}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"app.kubernetes.io/name": name}, Generation: 1},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "manager", Image: image}}},
+			},
+		},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: replicas, UpdatedReplicas: replicas, AvailableReplicas: replicas},
+	}
+}
+
+func TestNoOLMOperatorStatus(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps to the scheme: %v", err)
+	}
+	ns := bs.CSData.CPFSNs
+
+	healthy := newOperatorDeployment("ibm-im-operator", ns, "icr.io/cpopen/ibm-iam-operator:4.18.1", 1)
+	rolling := newOperatorDeployment("ibm-mgmt-ui-operator", ns, "icr.io/cpopen/ibm-commonui-operator@sha256:0123", 2)
+	rolling.Generation = 2
+	rolling.Status.UpdatedReplicas = 1
+	crashing := newOperatorDeployment("deploy-zen-operator", ns, "icr.io/cpopen/ibm-zen-operator:6.1.0", 1)
+	crashing.Name = "ibm-zen-operator"
+	crashing.Labels["app.kubernetes.io/version"] = "v6.1.1"
+	crashing.Status.AvailableReplicas = 0
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ibm-zen-operator-abc", Namespace: ns, Labels: map[string]string{"app.kubernetes.io/name": "deploy-zen-operator"}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "manager",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		}}},
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(healthy, rolling, crashing, pod).Build()
+	bs.Reader = bs.Client
+	bs.EventRecorder = record.NewFakeRecorder(10)
+	bs.Backend = NewInstallBackend(constant.InstallModeNoOLM, bs)
+
+	tests := []struct {
+		operator        string
+		expectedStatus  string
+		expectedVersion string
+		troubleshooting string
+	}{
+		{"ibm-im-operator", apiv3.CRSucceeded, "v4.18.1", ""},
+		{"ibm-mgmt-ui-operator", apiv3.CRUpdating, "", "rolling out"},
+		{"deploy-zen-operator", apiv3.CRFailed, "v6.1.1", "ibm-zen-operator-abc"},
+		{"ibm-events-operator", apiv3.CRNotReady, "", "not found"},
+	}
+	for _, tt := range tests {
+		opt, err := bs.Backend.OperatorStatus(context.Background(), &apiv3.CommonService{}, &odlm.Operator{Name: tt.operator, Namespace: ns})
+		if err != nil {
+			t.Fatalf("OperatorStatus of %s returned error: %v", tt.operator, err)
+		}
+		if opt.Name != tt.operator || opt.OperatorStatus != tt.expectedStatus || opt.Version != tt.expectedVersion {
+			t.Errorf("expected operator %s to be %s with version %q, got %+v", tt.operator, tt.expectedStatus, tt.expectedVersion, opt)
+		}
+		if !strings.Contains(opt.Troubleshooting, tt.troubleshooting) || (tt.troubleshooting == "") != (opt.Troubleshooting == "") {
+			t.Errorf("expected troubleshooting of operator %s to contain %q, got %q", tt.operator, tt.troubleshooting, opt.Troubleshooting)
+		}
+	}
+}
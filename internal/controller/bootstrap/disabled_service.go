@@ -0,0 +1,84 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// WithDisabledServices sets the operators of the disabled services to no-op,
+// so that ODLM doesn't install them
+func WithDisabledServices(services []apiv3.ServiceConfig) OperandRegistryOption {
+	return func(reg *odlm.OperandRegistry) error {
+		if reg == nil {
+			return fmt.Errorf("operand registry option received nil registry")
+		}
+
+		for i := range reg.Spec.Operators {
+			operator := &reg.Spec.Operators[i]
+			if apiv3.ServiceDisabled(services, operator.Name) == nil || operator.InstallMode == "no-op" {
+				continue
+			}
+			klog.Infof("Service %s is disabled, setting installMode of operator %s to no-op", apiv3.ServiceDisabled(services, operator.Name).Name, operator.Name)
+			operator.InstallMode = "no-op"
+		}
+
+		return nil
+	}
+}
+
+// CleanupDisabledServices deletes the Subscriptions and the
+// ClusterServiceVersions of the disabled services that request it
+func (b *Bootstrap) CleanupDisabledServices(ctx context.Context, services []apiv3.ServiceConfig) error {
+	if !b.UsesSubscriptions() {
+		return nil
+	}
+	cleanup := false
+	for _, service := range services {
+		cleanup = cleanup || service.Disabled() && service.DeleteSubscription
+	}
+	if !cleanup {
+		return nil
+	}
+
+	registry := &odlm.OperandRegistry{}
+	if err := b.Client.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: b.CSData.ServicesNs}, registry); err != nil {
+		return fmt.Errorf("failed to get OperandRegistry %s/%s: %v", b.CSData.ServicesNs, constant.MasterCR, err)
+	}
+	for _, operator := range registry.Spec.Operators {
+		service := apiv3.ServiceDisabled(services, operator.Name)
+		if service == nil || !service.DeleteSubscription {
+			continue
+		}
+		namespace := operator.Namespace
+		if namespace == "" {
+			namespace = b.CSData.CPFSNs
+		}
+		if err := b.deleteSubscription(operator.Name, namespace); err != nil {
+			return fmt.Errorf("failed to delete Subscription of disabled operator %s: %v", operator.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func TestWithDisabledServices(t *testing.T) {
+	reg := &odlm.OperandRegistry{
+		Spec: odlm.OperandRegistrySpec{
+			Operators: []odlm.Operator{
+				{Name: "ibm-im-operator"},
+				{Name: "ibm-im-operator-v4.2"},
+				{Name: "ibm-platformui-operator"},
+				{Name: "ibm-events-operator"},
+			},
+		},
+	}
+	services := []apiv3.ServiceConfig{
+		{Name: "ibm-im-operator", Enabled: pointer.Bool(false)},
+		{Name: "ibm-platformui-operator", Enabled: pointer.Bool(true)},
+		{Name: "ibm-events-operator"},
+	}
+
+	if err := WithDisabledServices(services)(reg); err != nil {
+		t.Fatalf("WithDisabledServices returned error: %v", err)
+	}
+
+	expected := map[string]string{
+		"ibm-im-operator":         "no-op",
+		"ibm-im-operator-v4.2":    "no-op",
+		"ibm-platformui-operator": "",
+		"ibm-events-operator":     "",
+	}
+	for _, operator := range reg.Spec.Operators {
+		if operator.InstallMode != expected[operator.Name] {
+			t.Errorf("expected installMode %q of operator %s, got %q", expected[operator.Name], operator.Name, operator.InstallMode)
+		}
+	}
+}
+
+func TestCleanupDisabledServices(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	ctx := context.Background()
+
+	scheme := bs.Client.Scheme()
+	if err := olmv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add OLM scheme: %v", err)
+	}
+	registry := &odlm.OperandRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs},
+		Spec: odlm.OperandRegistrySpec{
+			Operators: []odlm.Operator{
+				{Name: "ibm-im-operator", Namespace: bs.CSData.CPFSNs, InstallMode: "no-op"},
+				{Name: "ibm-platformui-operator", Namespace: bs.CSData.CPFSNs, InstallMode: "no-op"},
+			},
+		},
+	}
+	newSubscription := func(name, csv string) *olmv1alpha1.Subscription {
+		return &olmv1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bs.CSData.CPFSNs},
+			Status:     olmv1alpha1.SubscriptionStatus{InstalledCSV: csv},
+		}
+	}
+	newCSV := func(name string) *olmv1alpha1.ClusterServiceVersion {
+		return &olmv1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bs.CSData.CPFSNs}}
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		registry,
+		newSubscription("ibm-im-operator", "ibm-iam-operator.v4.12.0"),
+		newCSV("ibm-iam-operator.v4.12.0"),
+		newSubscription("ibm-platformui-operator", "ibm-zen-operator.v6.2.0"),
+		newCSV("ibm-zen-operator.v6.2.0"),
+	).Build()
+	bs.Reader = bs.Client
+
+	services := []apiv3.ServiceConfig{
+		{Name: "ibm-im-operator", Enabled: pointer.Bool(false), DeleteSubscription: true},
+		{Name: "ibm-platformui-operator", Enabled: pointer.Bool(false)},
+	}
+	if err := bs.CleanupDisabledServices(ctx, services); err != nil {
+		t.Fatalf("CleanupDisabledServices returned error: %v", err)
+	}
+
+	for _, obj := range []struct {
+		object  client.Object
+		deleted bool
+	}{
+		{&olmv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "ibm-im-operator"}}, true},
+		{&olmv1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "ibm-iam-operator.v4.12.0"}}, true},
+		{&olmv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "ibm-platformui-operator"}}, false},
+		{&olmv1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Name: "ibm-zen-operator.v6.2.0"}}, false},
+	} {
+		name := obj.object.GetName()
+		err := bs.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: bs.CSData.CPFSNs}, obj.object)
+		if obj.deleted && !errors.IsNotFound(err) {
+			t.Errorf("expected %s to be deleted, got %v", name, err)
+		} else if !obj.deleted && err != nil {
+			t.Errorf("expected %s to be kept, got %v", name, err)
+		}
+	}
+}
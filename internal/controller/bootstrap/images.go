@@ -0,0 +1,76 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// SetImageRegistry sets the mirrors, overrides, and digest pins applied to the
+// images of the OperandConfig
+func (b *Bootstrap) SetImageRegistry(registry *apiv3.ImageRegistry) error {
+	rewriter, err := util.NewImageRewriter(registry)
+	if err != nil {
+		return fmt.Errorf("invalid image registry: %v", err)
+	}
+	b.imageRewriter = rewriter
+	return nil
+}
+
+// RewriteServiceImages rewrites the images of the OperandConfig services in
+// place. It returns an error when an image doesn't match its digest pin, so
+// that the OperandConfig is not updated.
+func (b *Bootstrap) RewriteServiceImages(services []interface{}) error {
+	issues := b.imageRewriter.RewriteImages("services", services)
+	b.imageIssues = issues
+	if len(issues) == 0 {
+		return nil
+	}
+	for _, issue := range issues {
+		klog.Warningf("Invalid image in OperandConfig: %s", issue)
+	}
+	return fmt.Errorf("the images don't match their digest pins: %s", strings.Join(issues, "; "))
+}
+
+// rewriteOperandConfigImages rewrites the images of a rendered OperandConfig
+func (b *Bootstrap) rewriteOperandConfigImages(opcon *unstructured.Unstructured) error {
+	services, found, err := unstructured.NestedSlice(opcon.Object, "spec", "services")
+	if err != nil || !found {
+		return err
+	}
+	if err := b.RewriteServiceImages(services); err != nil {
+		return err
+	}
+	return unstructured.SetNestedSlice(opcon.Object, services, "spec", "services")
+}
+
+// checkImageWarning sets a warning for each image that doesn't match its
+// digest pin
+func (b *Bootstrap) checkImageWarning(instance *apiv3.CommonService) {
+	for _, issue := range b.imageIssues {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageImageNotPinned, issue))
+	}
+}
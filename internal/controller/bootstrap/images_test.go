@@ -0,0 +1,88 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/deploy"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+)
+
+func TestInstallOrUpdateOpconRewritesImages(t *testing.T) {
+	t.Parallel()
+
+	bs := buildTestBootstrap(t)
+	bs.Manager = &deploy.Manager{Client: bs.Client, Reader: bs.Reader}
+	bs.CSData.UtilsImage = "icr.io/cpopen/cpfs/cpfs-utils:4.6.0"
+	ctx := context.Background()
+
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{
+		ImageRegistry: &apiv3.ImageRegistry{
+			Mirrors: []apiv3.RegistryMirror{{Source: "icr.io/cpopen", Mirror: "mirror.example.com/cpopen"}},
+		},
+	}}
+	if err := bs.InstallOrUpdateOpcon(ctx, false, instance, nil, nil); err != nil {
+		t.Fatalf("InstallOrUpdateOpcon returned error: %v", err)
+	}
+
+	opcon := &odlm.OperandConfig{}
+	if err := bs.Client.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs}, opcon); err != nil {
+		t.Fatalf("expected OperandConfig to be created: %v", err)
+	}
+	specBytes, err := json.Marshal(opcon.Spec)
+	if err != nil {
+		t.Fatalf("failed to marshal OperandConfig: %v", err)
+	}
+	content := string(specBytes)
+	if strings.Contains(content, "icr.io/cpopen/cpfs/cpfs-utils") || !strings.Contains(content, "mirror.example.com/cpopen/cpfs/cpfs-utils:4.6.0") {
+		t.Fatalf("expected the utils image of the jobs to be mirrored")
+	}
+}
+
+func TestInstallOrUpdateOpconRejectsUnpinnedImages(t *testing.T) {
+	t.Parallel()
+
+	bs := buildTestBootstrap(t)
+	bs.Manager = &deploy.Manager{Client: bs.Client, Reader: bs.Reader}
+	bs.CSData.UtilsImage = "icr.io/cpopen/cpfs/cpfs-utils:4.6.0"
+	ctx := context.Background()
+
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{
+		ImageRegistry: &apiv3.ImageRegistry{
+			Images: []apiv3.ImageOverride{{Name: "icr.io/cpopen/cpfs/cpfs-utils", Digest: "sha256:" + strings.Repeat("a", 64)}},
+		},
+	}}
+	if err := bs.InstallOrUpdateOpcon(ctx, false, instance, nil, nil); err == nil {
+		t.Fatalf("expected InstallOrUpdateOpcon to fail for an image not matching its digest pin")
+	}
+	if len(bs.imageIssues) == 0 {
+		t.Fatalf("expected the image issues to be recorded")
+	}
+
+	bs.checkImageWarning(instance)
+	if len(instance.Status.Conditions) == 0 {
+		t.Fatalf("expected a warning condition for the image issues")
+	}
+}
@@ -36,6 +36,7 @@ import (
 	authzv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -86,6 +87,21 @@ type Bootstrap struct {
 	CSData                 apiv3.CSData
 	configMerger           ConfigMergerFunc
 	aggregatedConfigMerger AggregatedConfigMergerFunc
+	sizeProfileErrs        []error
+	autoSizeRestored       bool
+	sizingIssues           []string
+	imageRewriter          *util.ImageRewriter
+	imageIssues            []string
+	tlsProfileType         string
+	storageIssues          []string
+	priorityClassIssue     string
+	catalogIssues          []string
+	resolvedChannels       map[string][]string
+	templateOverlays       []templateOverlay
+	templateOverlayErrs    []error
+	customOperators        []templateOverlay
+	// Backend installs ODLM and reports the operators for the install mode
+	Backend InstallBackend
 }
 
 // CanI performs a SelfSubjectAccessReview (SSAR) to check whether the operator service account
@@ -134,20 +150,22 @@ type Resource struct {
 	Scope   string
 }
 
-func NewNonOLMBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
+// NewBootstrap is the way to create a NewBootstrap struct
+func NewBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
 	cpfsNs := util.GetCPFSNamespace(mgr.GetAPIReader())
 	servicesNs := util.GetServicesNamespace(mgr.GetAPIReader())
 	operatorNs, err := util.GetOperatorNamespace()
 	if err != nil {
 		return
 	}
+
 	csData := apiv3.CSData{
 		CPFSNs:                  cpfsNs,
 		ServicesNs:              servicesNs,
 		OperatorNs:              operatorNs,
 		CatalogSourceName:       "",
 		CatalogSourceNs:         "",
-		ApprovalMode:            "",
+		ODLMChannel:             constant.ODLMChannel,
 		WatchNamespaces:         util.GetWatchNamespace(),
 		OnPremMultiEnable:       strconv.FormatBool(util.CheckMultiInstances(mgr.GetAPIReader())),
 		ExcludedCatalog:         constant.ExcludedCatalog,
@@ -167,59 +185,19 @@ func NewNonOLMBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
 		MultiInstancesEnable: util.CheckMultiInstances(mgr.GetAPIReader()),
 		CSData:               csData,
 	}
-	return
-}
+	bs.Backend = NewInstallBackend(util.GetInstallMode(), bs)
+	klog.Infof("Install mode: %s", bs.Backend.Mode())
 
-// NewBootstrap is the way to create a NewBootstrap struct
-func NewBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
-	cpfsNs := util.GetCPFSNamespace(mgr.GetAPIReader())
-	servicesNs := util.GetServicesNamespace(mgr.GetAPIReader())
-	operatorNs, err := util.GetOperatorNamespace()
-	if err != nil {
+	if bs.CSData.ODLMCatalogSourceName, bs.CSData.ODLMCatalogSourceNs, err = bs.Backend.ResolveCatalog(ctx); err != nil {
 		return
 	}
-
-	odlmCatalogSourceName, odlmcatalogSourceNs := util.GetCatalogSource(constant.IBMCSPackage, operatorNs, mgr.GetAPIReader())
-	if odlmCatalogSourceName == "" || odlmcatalogSourceNs == "" {
-		err = fmt.Errorf("failed to get ODLM catalogsource")
+	if bs.CSData.ApprovalMode, err = bs.Backend.ApprovalMode(ctx); err != nil {
 		return
 	}
-
-	approvalMode, err := util.GetApprovalModeinNs(mgr.GetAPIReader(), operatorNs)
-	if err != nil {
+	if bs.InstallMode() == constant.InstallModeNoOLM {
 		return
 	}
 
-	csData := apiv3.CSData{
-		CPFSNs:                  cpfsNs,
-		ServicesNs:              servicesNs,
-		OperatorNs:              operatorNs,
-		CatalogSourceName:       "",
-		CatalogSourceNs:         "",
-		ODLMChannel:             constant.ODLMChannel,
-		ODLMCatalogSourceName:   odlmCatalogSourceName,
-		ODLMCatalogSourceNs:     odlmcatalogSourceNs,
-		ApprovalMode:            approvalMode,
-		WatchNamespaces:         util.GetWatchNamespace(),
-		OnPremMultiEnable:       strconv.FormatBool(util.CheckMultiInstances(mgr.GetAPIReader())),
-		ExcludedCatalog:         constant.ExcludedCatalog,
-		StatusMonitoredServices: constant.StatusMonitoredServices,
-		ServiceNames:            constant.ServiceNames,
-		UtilsImage:              util.GetUtilsImage(),
-		ImagePullSecret:         constant.DefaultImagePullSecret,
-	}
-
-	bs = &Bootstrap{
-		Client:               mgr.GetClient(),
-		Reader:               mgr.GetAPIReader(),
-		Config:               mgr.GetConfig(),
-		EventRecorder:        mgr.GetEventRecorderFor("ibm-common-service-operator"),
-		Manager:              deploy.NewDeployManager(mgr),
-		SaasEnable:           false,
-		MultiInstancesEnable: util.CheckMultiInstances(mgr.GetAPIReader()),
-		CSData:               csData,
-	}
-
 	// Get all the resources from the deployment annotations
 	annotations, err := bs.GetAnnotations()
 	if err != nil {
@@ -246,6 +224,10 @@ func NewBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
 func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonService, forceUpdateODLMCRs bool, aggregatedConfigs []interface{}, serviceControllerMapping map[string]string) error {
 	installPlanApproval := instance.Spec.InstallPlanApproval
 	userManagedOption := WithUserManagedOverridesFromConfigs(instance.Spec.OperatorConfigs)
+	placementOption := WithPodPlacement(instance.Spec.Placement)
+	proxyOption := WithProxy(b.ResolveProxy(ctx, instance.Spec.Proxy), b.CSData.ServicesNs, b.CSData.OperatorNs)
+	disabledOption := WithDisabledServices(instance.Spec.Services)
+	pinnedOption := WithPinnedVersions(instance.Spec.OperatorConfigs)
 
 	if installPlanApproval != "" {
 		if installPlanApproval != olmv1alpha1.ApprovalAutomatic && installPlanApproval != olmv1alpha1.ApprovalManual {
@@ -311,7 +293,7 @@ func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonSer
 		}
 
 		klog.Info("Installing/Updating OperandRegistry")
-		if err := b.InstallOrUpdateOpreg(ctx, installPlanApproval, userManagedOption); err != nil {
+		if err := b.InstallOrUpdateOpreg(ctx, installPlanApproval, userManagedOption, placementOption, proxyOption, disabledOption, pinnedOption); err != nil {
 			return err
 		}
 
@@ -321,24 +303,15 @@ func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonSer
 		}
 	}
 
-	// Install ODLM Operator
-	// Check if CatalogSource contains the correct version of ODLM
-	// if contains, install ODLM Operator
-	// if not, skip the installation of ODLM Operator, and show warning event
-	if installODLM, err := util.CheckODLMCatalogSource(b.Reader, constant.ODLMPackageName, b.CSData.ODLMCatalogSourceName, b.CSData.ODLMCatalogSourceNs, b.CSData.OperatorNs); err != nil {
-		return err
-	} else if installODLM {
-		klog.Info("Installing ODLM Operator")
-		if err := b.renderTemplate(constant.ODLMSubscription, b.CSData, nil); err != nil {
-			return err
+	// Report the packages missing from the catalogs before ODLM installs them
+	if b.UsesSubscriptions() {
+		if err := b.ValidateCatalogs(ctx, installPlanApproval, userManagedOption, placementOption, proxyOption, disabledOption, pinnedOption); err != nil {
+			klog.Warningf("Failed to validate catalogs: %v", err)
 		}
-	} else {
-		b.EventRecorder.Event(instance, corev1.EventTypeWarning, "ODLMCatalogSourceWarning", fmt.Sprintf("The catalogsource %s in namespace %s does not contain the correct version of ODLM, skip the installation/update of ODLM Operator", b.CSData.ODLMCatalogSourceName, b.CSData.ODLMCatalogSourceNs))
-		return fmt.Errorf("the catalogsource %s in namespace %s does not contain the correct version of ODLM, skip the installation/update of ODLM Operator", b.CSData.ODLMCatalogSourceName, b.CSData.ODLMCatalogSourceNs)
 	}
 
-	klog.Info("Waiting for ODLM Operator to be ready")
-	if isWaiting, err := b.waitOperatorCSV(constant.IBMODLMPackage, "ibm-odlm", b.CSData.CPFSNs); err != nil {
+	// Install ODLM Operator
+	if isWaiting, err := b.installBackend().InstallODLM(ctx, instance); err != nil {
 		return err
 	} else if isWaiting {
 		forceUpdateODLMCRs = true
@@ -361,7 +334,7 @@ func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonSer
 		}
 
 		klog.Info("Installing/Updating OperandRegistry")
-		if err := b.InstallOrUpdateOpreg(ctx, installPlanApproval, userManagedOption); err != nil {
+		if err := b.InstallOrUpdateOpreg(ctx, installPlanApproval, userManagedOption, placementOption, proxyOption, disabledOption, pinnedOption); err != nil {
 			return err
 		}
 
@@ -370,6 +343,11 @@ func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonSer
 			return err
 		}
 	}
+
+	// Uninstall the operators of the disabled services
+	if err := b.CleanupDisabledServices(ctx, instance.Spec.Services); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -377,25 +355,28 @@ func (b *Bootstrap) InitResources(ctx context.Context, instance *apiv3.CommonSer
 // Currently validates StorageClass configuration.
 func (b *Bootstrap) CheckWarningCondition(instance *apiv3.CommonService) error {
 	b.checkStorageClassWarning(instance)
+	b.checkPriorityClassWarning(instance)
+	b.checkSizeProfileWarning(instance)
+	b.checkSizingWarning(instance)
+	b.checkImageWarning(instance)
+	b.checkTLSProfileWarning(instance)
+	b.checkBackupWarning(instance)
+	b.checkRestoreWarning(instance)
+	b.checkVersionSkewWarning(instance)
+	b.checkCatalogWarning(instance)
+	b.checkTemplateOverlayWarning(instance)
 	return nil
 }
 
 // checkStorageClassWarning validates StorageClass configuration and sets warning if needed.
 // Uses SSAR to check permission first; skips if not permitted.
 func (b *Bootstrap) checkStorageClassWarning(instance *apiv3.CommonService) {
-	allowed, err := b.CanI(context.TODO(), "storage.k8s.io", "storageclasses", "list", "")
-	if err != nil {
-		klog.Warningf("SSAR check for storageclasses list failed: %v, skipping StorageClass warning check", err)
-		return
-	}
-	if !allowed {
-		klog.Warningf("No permission to list storageclasses, skipping StorageClass warning check")
-		return
+	for _, issue := range b.storageIssues {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageStorageClass, issue))
 	}
 
-	csStorageClass := &storagev1.StorageClassList{}
-	if err := b.Reader.List(context.TODO(), csStorageClass); err != nil {
-		klog.V(2).Infof("Failed to list StorageClasses: %v", err)
+	csStorageClass, ok := b.ListStorageClasses(context.TODO(), "StorageClass warning check")
+	if !ok {
 		return
 	}
 
@@ -416,28 +397,100 @@ func (b *Bootstrap) checkStorageClassWarning(instance *apiv3.CommonService) {
 // CheckStorageClass validates whether StorageClass exists in the cluster.
 // Uses SSAR to check permission first; skips if not permitted.
 func (b *Bootstrap) CheckStorageClass() {
-	allowed, err := b.CanI(context.TODO(), "storage.k8s.io", "storageclasses", "list", "")
-	if err != nil {
-		klog.Warningf("SSAR check for storageclasses list failed: %v, skipping StorageClass check", err)
+	csStorageClass, ok := b.ListStorageClasses(context.TODO(), "StorageClass check")
+	if !ok {
 		return
 	}
+
+	size := len(csStorageClass.Items)
+	klog.Info("StorageClass Number: ", size)
+
+	if size <= 0 {
+		klog.Warning("StorageClass is not found, which might be required by CloudPak services, please refer to CloudPak's documentation for prerequisites.")
+	}
+}
+
+// ListStorageClasses lists the StorageClasses of the cluster. It returns false
+// when they can't be listed, the check is skipped.
+func (b *Bootstrap) ListStorageClasses(ctx context.Context, check string) (*storagev1.StorageClassList, bool) {
+	allowed, err := b.CanI(ctx, "storage.k8s.io", "storageclasses", "list", "")
+	if err != nil {
+		klog.Warningf("SSAR check for storageclasses list failed: %v, skipping %s", err, check)
+		return nil, false
+	}
 	if !allowed {
-		klog.Warningf("No permission to list storageclasses, skipping StorageClass check")
-		return
+		klog.Warningf("No permission to list storageclasses, skipping %s", check)
+		return nil, false
 	}
 
-	csStorageClass := &storagev1.StorageClassList{}
-	if err := b.Reader.List(context.TODO(), csStorageClass); err != nil {
+	storageClasses := &storagev1.StorageClassList{}
+	if err := b.Reader.List(ctx, storageClasses); err != nil {
 		klog.V(2).Infof("Failed to list StorageClasses: %v", err)
+		return nil, false
+	}
+	return storageClasses, true
+}
+
+// CheckServiceStorageClasses records the storage classes of the services that
+// don't exist in the cluster, they are reported as warnings
+func (b *Bootstrap) CheckServiceStorageClasses(ctx context.Context, storage []apiv3.ServiceStorage) {
+	b.storageIssues = nil
+	if len(storage) == 0 {
+		return
+	}
+	storageClasses, ok := b.ListStorageClasses(ctx, "service StorageClass check")
+	if !ok {
 		return
 	}
 
-	size := len(csStorageClass.Items)
-	klog.Info("StorageClass Number: ", size)
+	existing := map[string]bool{}
+	for _, sc := range storageClasses.Items {
+		existing[sc.Name] = true
+	}
+	for _, serviceStorage := range storage {
+		if serviceStorage.StorageClass == "" || existing[serviceStorage.StorageClass] {
+			continue
+		}
+		klog.Warningf("StorageClass %s of service %s is not found", serviceStorage.StorageClass, serviceStorage.Name)
+		b.storageIssues = append(b.storageIssues, fmt.Sprintf("%s of service %s", serviceStorage.StorageClass, serviceStorage.Name))
+	}
+}
 
-	if size <= 0 {
-		klog.Warning("StorageClass is not found, which might be required by CloudPak services, please refer to CloudPak's documentation for prerequisites.")
+// CheckPriorityClass records the priority class of the operands when it
+// doesn't exist in the cluster, it is reported as a warning
+func (b *Bootstrap) CheckPriorityClass(ctx context.Context, name string) {
+	b.priorityClassIssue = ""
+	if name == "" {
+		return
+	}
+	allowed, err := b.CanI(ctx, "scheduling.k8s.io", "priorityclasses", "get", name)
+	if err != nil {
+		klog.Warningf("SSAR check for priorityclasses get failed: %v, skipping PriorityClass check", err)
+		return
+	}
+	if !allowed {
+		klog.Warningf("No permission to get priorityclasses, skipping PriorityClass check")
+		return
+	}
+
+	priorityClass := &schedulingv1.PriorityClass{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: name}, priorityClass); err != nil {
+		if errors.IsNotFound(err) {
+			klog.Warningf("PriorityClass %s of the operands is not found", name)
+			b.priorityClassIssue = name
+			return
+		}
+		klog.V(2).Infof("Failed to get PriorityClass %s: %v", name, err)
+	}
+}
+
+// checkPriorityClassWarning sets a warning when the PriorityClass of the
+// operands doesn't exist
+func (b *Bootstrap) checkPriorityClassWarning(instance *apiv3.CommonService) {
+	if b.priorityClassIssue == "" {
+		return
 	}
+	instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessagePriorityClass, b.priorityClassIssue))
 }
 
 func (b *Bootstrap) CreateNamespace(name string) error {
@@ -1058,6 +1111,12 @@ func (b *Bootstrap) InstallOrUpdateOpreg(ctx context.Context, installPlanApprova
 // InstallOrUpdateOpcon will install or update OperandConfig when Opcon CRD is existent
 // Now accepts CommonService instance with merged configurations
 func (b *Bootstrap) InstallOrUpdateOpcon(ctx context.Context, forceUpdateODLMCRs bool, csInstance *apiv3.CommonService, aggregatedConfigs []interface{}, serviceControllerMapping map[string]string) error {
+	if csInstance != nil {
+		if err := b.SetImageRegistry(csInstance.Spec.ImageRegistry); err != nil {
+			return err
+		}
+	}
+
 	// Get base template configs using common utility
 	configs := common.GetBaseOperandConfigList()
 
@@ -1066,6 +1125,10 @@ func (b *Bootstrap) InstallOrUpdateOpcon(ctx context.Context, forceUpdateODLMCRs
 		klog.Errorf("failed to concatenate OperandConfig: %v", err)
 		return err
 	}
+	if concatenatedCon, err = b.ApplyOperandConfigOverlays(concatenatedCon); err != nil {
+		klog.Errorf("failed to apply template overlays to OperandConfig: %v", err)
+		return err
+	}
 
 	// Merge CommonService configurations with base templates
 	// before rendering to create complete OperandConfig in one step
@@ -1508,6 +1571,11 @@ func (b *Bootstrap) renderTemplateWithExistingMerge(objectTemplate string, data
 		if gvk.Kind == "OperandConfig" && gvk.Group == "operator.ibm.com" {
 			klog.Info("Merging new OperandConfig with existing to preserve manually added fields")
 
+			// Rewrite the images for the mirrored registries
+			if err := b.rewriteOperandConfigImages(newObj); err != nil {
+				return err
+			}
+
 			// Get existing OperandConfig from cluster
 			existingObj, err := b.GetObject(newObj)
 			if err != nil {
@@ -1804,7 +1872,7 @@ func (b *Bootstrap) updateApprovalMode() error {
 		return err
 	}
 
-	if err = b.UpdateCsOpApproval(); err != nil {
+	if err = b.installBackend().UpdateApproval(ctx); err != nil {
 		klog.Errorf("Failed to update %s subscription: %v", constant.IBMCSPackage, err)
 		return err
 	}
@@ -1827,8 +1895,8 @@ func (b *Bootstrap) DeployResource(cr, placeholder string) bool {
 	return true
 }
 
-// isOpenShiftCluster checks if the cluster is OpenShift by looking for route.openshift.io API group.
-func (b *Bootstrap) isOpenShiftCluster() bool {
+// IsOpenShiftCluster checks if the cluster is OpenShift by looking for route.openshift.io API group.
+func (b *Bootstrap) IsOpenShiftCluster() bool {
 	dc, err := discovery.NewDiscoveryClientForConfig(b.Config)
 	if err != nil {
 		klog.Warningf("Failed to create discovery client: %v", err)
@@ -1849,7 +1917,7 @@ func (b *Bootstrap) isOpenShiftCluster() bool {
 
 func (b *Bootstrap) CheckClusterType(ns string) (bool, error) {
 	// Detect OCP by checking if route.openshift.io API group exists
-	isOCP := b.isOpenShiftCluster()
+	isOCP := b.IsOpenShiftCluster()
 	klog.Infof("Cluster type is OCP: %v", isOCP)
 
 	config := &corev1.ConfigMap{}
@@ -2818,11 +2886,14 @@ func (b *Bootstrap) CheckSubOperatorStatus(instance *apiv3.CommonService) (bool,
 			klog.Infof("The operator %s is user-managed, skipping status checks", operator.Name)
 			continue
 		}
-		optStatus, err := b.setOperatorStatus(instance, operator.Name, operator.PackageName, operator.Namespace)
+		optStatus, err := b.installBackend().OperatorStatus(ctx, instance, operator)
 		if err != nil {
 			klog.Errorf("Failed to get operator status: %v", err)
 			return false, err
 		}
+		if optStatus.Channel == "" {
+			optStatus.Channel = operator.Channel
+		}
 		// Only optStatus append into the operatorSlice if the optStatus.name is not duplicated
 		// Otherwise overwrite the existing one
 		if len(operatorSlice) == 0 {
@@ -2902,6 +2973,10 @@ func (b *Bootstrap) setOperatorStatus(instance *apiv3.CommonService, name, packa
 		opt.OperatorStatus = apiv3.CRNotReady
 	}
 
+	if sub.Spec != nil {
+		opt.Channel = sub.Spec.Channel
+	}
+
 	// fetch installplanName
 	installplanName := ""
 	if sub.Status.Install != nil {
@@ -0,0 +1,169 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// InstallBackend installs ODLM and reports the operators of the foundational
+// services for an install mode
+type InstallBackend interface {
+	// Mode returns the install mode of the backend
+	Mode() string
+	// ResolveCatalog returns the catalog ODLM is installed from, it is empty
+	// when ODLM is not installed by this operator
+	ResolveCatalog(ctx context.Context) (string, string, error)
+	// ApprovalMode returns the approval mode this operator is installed with
+	ApprovalMode(ctx context.Context) (string, error)
+	// UpdateApproval applies the approval mode of CSData to this operator
+	UpdateApproval(ctx context.Context) error
+	// InstallODLM installs or updates ODLM and waits for it, it returns true
+	// when ODLM wasn't ready yet
+	InstallODLM(ctx context.Context, instance *apiv3.CommonService) (bool, error)
+	// OperatorStatus returns the status of an operator in the OperandRegistry
+	OperatorStatus(ctx context.Context, instance *apiv3.CommonService, operator *odlm.Operator) (apiv3.BedrockOperator, error)
+	// UsesSubscriptions returns true when ODLM installs the operators in the
+	// OperandRegistry with OLM Subscriptions
+	UsesSubscriptions() bool
+}
+
+// NewInstallBackend returns the install backend of the install mode
+func NewInstallBackend(mode string, b *Bootstrap) InstallBackend {
+	switch mode {
+	case constant.InstallModeNoOLM:
+		return &noOLMBackend{b}
+	case constant.InstallModeOLMv1:
+		return &olmV1Backend{b}
+	}
+	return &olmBackend{b}
+}
+
+// installBackend returns the install backend, OLM is used when it is not set
+func (b *Bootstrap) installBackend() InstallBackend {
+	if b.Backend == nil {
+		return &olmBackend{b}
+	}
+	return b.Backend
+}
+
+// InstallMode returns the install mode of the operator
+func (b *Bootstrap) InstallMode() string {
+	return b.installBackend().Mode()
+}
+
+// UsesSubscriptions returns true when the operators in the OperandRegistry
+// are installed with OLM Subscriptions
+func (b *Bootstrap) UsesSubscriptions() bool {
+	return b.installBackend().UsesSubscriptions()
+}
+
+// olmBackend installs the operators with OLM Subscriptions
+type olmBackend struct {
+	*Bootstrap
+}
+
+func (o *olmBackend) Mode() string {
+	return constant.InstallModeOLM
+}
+
+func (o *olmBackend) ResolveCatalog(ctx context.Context) (string, string, error) {
+	name, namespace := util.GetCatalogSource(constant.IBMCSPackage, o.CSData.OperatorNs, o.Reader)
+	if name == "" || namespace == "" {
+		return "", "", fmt.Errorf("failed to get ODLM catalogsource")
+	}
+	return name, namespace, nil
+}
+
+func (o *olmBackend) ApprovalMode(ctx context.Context) (string, error) {
+	return util.GetApprovalModeinNs(o.Reader, o.CSData.OperatorNs)
+}
+
+func (o *olmBackend) UpdateApproval(ctx context.Context) error {
+	return o.UpdateCsOpApproval()
+}
+
+func (o *olmBackend) InstallODLM(ctx context.Context, instance *apiv3.CommonService) (bool, error) {
+	// Check if CatalogSource contains the correct version of ODLM
+	// if contains, install ODLM Operator
+	// if not, skip the installation of ODLM Operator, and show warning event
+	if installODLM, err := util.CheckODLMCatalogSource(o.Reader, constant.ODLMPackageName, o.CSData.ODLMCatalogSourceName, o.CSData.ODLMCatalogSourceNs, o.CSData.OperatorNs); err != nil {
+		return false, err
+	} else if installODLM {
+		klog.Info("Installing ODLM Operator")
+		if err := o.renderTemplate(constant.ODLMSubscription, o.CSData, nil); err != nil {
+			return false, err
+		}
+	} else {
+		o.EventRecorder.Event(instance, corev1.EventTypeWarning, "ODLMCatalogSourceWarning", fmt.Sprintf("The catalogsource %s in namespace %s does not contain the correct version of ODLM, skip the installation/update of ODLM Operator", o.CSData.ODLMCatalogSourceName, o.CSData.ODLMCatalogSourceNs))
+		return false, fmt.Errorf("the catalogsource %s in namespace %s does not contain the correct version of ODLM, skip the installation/update of ODLM Operator", o.CSData.ODLMCatalogSourceName, o.CSData.ODLMCatalogSourceNs)
+	}
+
+	klog.Info("Waiting for ODLM Operator to be ready")
+	return o.waitOperatorCSV(constant.IBMODLMPackage, constant.ODLMPackageName, o.CSData.CPFSNs)
+}
+
+func (o *olmBackend) OperatorStatus(ctx context.Context, instance *apiv3.CommonService, operator *odlm.Operator) (apiv3.BedrockOperator, error) {
+	return o.setOperatorStatus(instance, operator.Name, operator.PackageName, operator.Namespace)
+}
+
+func (o *olmBackend) UsesSubscriptions() bool {
+	return true
+}
+
+// noOLMBackend is used when ODLM and the operators are deployed without OLM,
+// ODLM is deployed with this operator
+type noOLMBackend struct {
+	*Bootstrap
+}
+
+func (n *noOLMBackend) Mode() string {
+	return constant.InstallModeNoOLM
+}
+
+func (n *noOLMBackend) ResolveCatalog(ctx context.Context) (string, string, error) {
+	return "", "", nil
+}
+
+func (n *noOLMBackend) ApprovalMode(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (n *noOLMBackend) UpdateApproval(ctx context.Context) error {
+	return nil
+}
+
+func (n *noOLMBackend) InstallODLM(ctx context.Context, instance *apiv3.CommonService) (bool, error) {
+	return false, nil
+}
+
+func (n *noOLMBackend) OperatorStatus(ctx context.Context, instance *apiv3.CommonService, operator *odlm.Operator) (apiv3.BedrockOperator, error) {
+	return n.setDeploymentStatus(ctx, instance, operator.Name, operator.Namespace)
+}
+
+func (n *noOLMBackend) UsesSubscriptions() bool {
+	return false
+}
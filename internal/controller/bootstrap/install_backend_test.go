@@ -0,0 +1,167 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func TestNewInstallBackend(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	if mode := bs.InstallMode(); mode != constant.InstallModeOLM {
+		t.Fatalf("expected the OLM install mode by default, got %s", mode)
+	}
+
+	t.Setenv("INSTALL_MODE", constant.InstallModeOLMv1)
+	if mode := util.GetInstallMode(); mode != constant.InstallModeOLMv1 {
+		t.Fatalf("expected the OLM v1 install mode, got %s", mode)
+	}
+	t.Setenv("NO_OLM", "true")
+	bs.Backend = NewInstallBackend(util.GetInstallMode(), bs)
+	if mode := bs.InstallMode(); mode != constant.InstallModeNoOLM {
+		t.Fatalf("expected NO_OLM to select the no-OLM install mode, got %s", mode)
+	}
+	if waiting, err := bs.Backend.InstallODLM(context.Background(), &apiv3.CommonService{}); err != nil || waiting {
+		t.Fatalf("expected ODLM not to be installed without OLM, got %v, %v", waiting, err)
+	}
+	if bs.UsesSubscriptions() {
+		t.Fatalf("expected no Subscriptions without OLM")
+	}
+}
+
+func createClusterExtension(t *testing.T, bs *Bootstrap, name, packageName, namespace, version string) *unstructured.Unstructured {
+	t.Helper()
+	ext := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"namespace":      namespace,
+			"serviceAccount": map[string]interface{}{"name": "cs-installer"},
+			"source": map[string]interface{}{
+				"sourceType": "Catalog",
+				"catalog": map[string]interface{}{
+					"packageName": packageName,
+					"selector":    map[string]interface{}{"matchLabels": map[string]interface{}{constant.ClusterCatalogLabel: "ibm-operator-catalog"}},
+				},
+			},
+		},
+		"status": map[string]interface{}{
+			"install":    map[string]interface{}{"bundle": map[string]interface{}{"name": packageName + ".v" + version, "version": version}},
+			"conditions": []interface{}{map[string]interface{}{"type": "Installed", "status": "True"}},
+		},
+	}}
+	ext.SetAPIVersion(constant.ClusterExtensionAPIVersion)
+	ext.SetKind("ClusterExtension")
+	ext.SetName(name)
+	if err := bs.Client.Create(context.Background(), ext); err != nil {
+		t.Fatalf("failed to create the ClusterExtension: %v", err)
+	}
+	return ext
+}
+
+func TestOLMV1Backend(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := olmv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add operators/v1alpha1 to the scheme: %v", err)
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).Build()
+	bs.Reader = bs.Client
+	bs.EventRecorder = record.NewFakeRecorder(10)
+	bs.CSData.OperatorNs = bs.CSData.CPFSNs
+	bs.CSData.ODLMChannel = constant.ODLMChannel
+	bs.CSData.ApprovalMode = string(olmv1alpha1.ApprovalManual)
+	bs.Backend = NewInstallBackend(constant.InstallModeOLMv1, bs)
+	ctx := context.Background()
+
+	createClusterExtension(t, bs, "ibm-common-service-operator", constant.IBMCSPackage, bs.CSData.OperatorNs, "4.19.2")
+	catalog, _, err := bs.Backend.ResolveCatalog(ctx)
+	if err != nil || catalog != "ibm-operator-catalog" {
+		t.Fatalf("expected the ClusterCatalog of the operator, got %q, %v", catalog, err)
+	}
+	if approval, err := bs.Backend.ApprovalMode(ctx); err != nil || approval != string(olmv1alpha1.ApprovalAutomatic) {
+		t.Fatalf("expected the Automatic approval of an unpinned ClusterExtension, got %q, %v", approval, err)
+	}
+	if err := bs.Backend.UpdateApproval(ctx); err != nil {
+		t.Fatalf("UpdateApproval returned error: %v", err)
+	}
+	if approval, err := bs.Backend.ApprovalMode(ctx); err != nil || approval != string(olmv1alpha1.ApprovalManual) {
+		t.Fatalf("expected the Manual approval to pin the ClusterExtension, got %q, %v", approval, err)
+	}
+
+	bs.CSData.ODLMCatalogSourceName = catalog
+	waiting, err := bs.Backend.InstallODLM(ctx, &apiv3.CommonService{})
+	if err != nil || !waiting {
+		t.Fatalf("expected to wait for the ODLM ClusterExtension, got %v, %v", waiting, err)
+	}
+	ext := &unstructured.Unstructured{}
+	ext.SetAPIVersion(constant.ClusterExtensionAPIVersion)
+	ext.SetKind("ClusterExtension")
+	if err := bs.Client.Get(ctx, types.NamespacedName{Name: constant.IBMODLMPackage + "-" + bs.CSData.CPFSNs}, ext); err != nil {
+		t.Fatalf("failed to get the ODLM ClusterExtension: %v", err)
+	}
+	for expected, fields := range map[string][]string{
+		bs.CSData.CPFSNs:         {"spec", "namespace"},
+		"cs-installer":           {"spec", "serviceAccount", "name"},
+		constant.ODLMPackageName: {"spec", "source", "catalog", "packageName"},
+		catalog:                  {"spec", "source", "catalog", "selector", "matchLabels", constant.ClusterCatalogLabel},
+	} {
+		if value, _, _ := unstructured.NestedString(ext.Object, fields...); value != expected {
+			t.Errorf("expected %v to be %s, got %s", fields, expected, value)
+		}
+	}
+
+	createClusterExtension(t, bs, "ibm-im-operator", "ibm-iam-operator", bs.CSData.ServicesNs, "4.18.1")
+	status, err := bs.Backend.OperatorStatus(ctx, &apiv3.CommonService{}, &odlm.Operator{Name: "ibm-im-operator", PackageName: "ibm-iam-operator", Namespace: bs.CSData.ServicesNs})
+	if err != nil {
+		t.Fatalf("OperatorStatus returned error: %v", err)
+	}
+	if status.Name != "ibm-iam-operator" || status.Version != "v4.18.1" || status.OperatorStatus != apiv3.CRSucceeded {
+		t.Fatalf("expected the installed IM operator, got %+v", status)
+	}
+
+	// the operators installed by ODLM are found by their Subscriptions
+	sub := &olmv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-operator", Namespace: bs.CSData.ServicesNs},
+		Spec:       &olmv1alpha1.SubscriptionSpec{Package: "rhbk-operator", Channel: "stable-v26"},
+		Status:     olmv1alpha1.SubscriptionStatus{InstalledCSV: "rhbk-operator.v26.0.5"},
+	}
+	if err := bs.Client.Create(ctx, sub); err != nil {
+		t.Fatalf("failed to create the Subscription: %v", err)
+	}
+	status, err = bs.Backend.OperatorStatus(ctx, &apiv3.CommonService{}, &odlm.Operator{Name: "keycloak-operator", PackageName: "rhbk-operator", Namespace: bs.CSData.ServicesNs})
+	if err != nil {
+		t.Fatalf("OperatorStatus returned error for an operator without ClusterExtension: %v", err)
+	}
+	if status.Name != "rhbk-operator" || status.Version != "v26.0.5" || status.Channel != "stable-v26" {
+		t.Fatalf("expected the status of the keycloak Subscription, got %+v", status)
+	}
+	if !bs.UsesSubscriptions() {
+		t.Fatalf("expected ODLM to install the operators with Subscriptions in the OLM v1 install mode")
+	}
+}
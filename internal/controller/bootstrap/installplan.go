@@ -0,0 +1,291 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// ApproveInstallPlans approves the pending InstallPlans in the operator
+// namespaces allowed by the InstallPlanApprovalPolicy when the maintenance
+// window is open, and records them in the status. Only the InstallPlans of
+// the operators in the OperandRegistry and of ODLM are approved.
+func (b *Bootstrap) ApproveInstallPlans(ctx context.Context, instance *apiv3.CommonService) error {
+	policy := instance.Spec.InstallPlanApprovalPolicy
+	// InstallPlans are only created by OLM Subscriptions
+	if policy == nil || instance.Spec.InstallPlanApproval != olmv1alpha1.ApprovalManual || !b.UsesSubscriptions() {
+		instance.Status.InstallPlanApproval = nil
+		return nil
+	}
+	status := instance.Status.InstallPlanApproval
+	if status == nil {
+		status = &apiv3.InstallPlanApprovalStatus{}
+		instance.Status.InstallPlanApproval = status
+	}
+
+	pending, err := b.listPendingInstallPlans(ctx)
+	if err != nil {
+		return err
+	}
+	managed, err := b.registryCSVs(ctx)
+	if err != nil {
+		return err
+	}
+	pins := pinnedCSVs(instance.Spec.OperatorConfigs)
+	var allowed []*olmv1alpha1.InstallPlan
+	status.NotAllowed = nil
+	for _, ip := range pending {
+		ipAllowed := true
+		for _, csv := range ip.Spec.ClusterServiceVersionNames {
+			csvAllowed, err := util.CSVAllowed(policy, csv)
+			if err != nil {
+				klog.Warningf("InstallPlan %s/%s is not approved: %v", ip.Namespace, ip.Name, err)
+			}
+			// only the operators of the foundational services are approved
+			if !managed[csv] {
+				klog.V(2).Infof("CSV %s of InstallPlan %s/%s is not installed by a Subscription of the OperandRegistry", csv, ip.Namespace, ip.Name)
+				csvAllowed = false
+			}
+			// a pinned operator is only upgraded to its pinned CSV
+			if pinned, matched := csvPinned(pins, csv); pinned && !matched {
+				csvAllowed = false
+			}
+			if !csvAllowed {
+				status.NotAllowed = append(status.NotAllowed, csv)
+				ipAllowed = false
+			}
+		}
+		if ipAllowed {
+			allowed = append(allowed, ip)
+		}
+	}
+	sort.Strings(status.NotAllowed)
+
+	if len(allowed) == 0 {
+		return nil
+	}
+	if policy.ApproveTogether && len(allowed) < len(pending) {
+		klog.Infof("Waiting for all the pending InstallPlans to be allowed, CSVs %v are not allowed", status.NotAllowed)
+		return nil
+	}
+	open, err := util.InMaintenanceWindow(&policy.MaintenanceWindow, time.Now())
+	if err != nil {
+		return err
+	}
+	if !open {
+		return nil
+	}
+
+	for _, ip := range allowed {
+		klog.Infof("Approving InstallPlan %s/%s for CSVs %v", ip.Namespace, ip.Name, ip.Spec.ClusterServiceVersionNames)
+		ip.Spec.Approved = true
+		if err := b.Client.Update(ctx, ip); err != nil {
+			return fmt.Errorf("failed to approve InstallPlan %s/%s: %v", ip.Namespace, ip.Name, err)
+		}
+		status.Approved = append(status.Approved, apiv3.ApprovedInstallPlan{
+			Name:                   ip.Name,
+			Namespace:              ip.Namespace,
+			ClusterServiceVersions: ip.Spec.ClusterServiceVersionNames,
+			ApprovalTime:           time.Now().UTC().Format(time.RFC3339),
+		})
+	}
+	if len(status.Approved) > constant.MaxApprovedInstallPlans {
+		status.Approved = status.Approved[len(status.Approved)-constant.MaxApprovedInstallPlans:]
+	}
+	return nil
+}
+
+// installPlanNamespaces returns the namespaces of the operator Subscriptions
+func (b *Bootstrap) installPlanNamespaces() []string {
+	namespaces := []string{b.CSData.CPFSNs}
+	if b.CSData.OperatorNs != b.CSData.CPFSNs {
+		namespaces = append(namespaces, b.CSData.OperatorNs)
+	}
+	return namespaces
+}
+
+// registryCSVs returns the CSVs installed by the Subscriptions of the packages
+// in the OperandRegistry and of ODLM, in the operator namespaces
+func (b *Bootstrap) registryCSVs(ctx context.Context) (map[string]bool, error) {
+	packages := map[string]bool{constant.ODLMPackageName: true}
+	registry := &odlm.OperandRegistry{}
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: b.CSData.ServicesNs}, registry); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get OperandRegistry %s/%s: %v", b.CSData.ServicesNs, constant.MasterCR, err)
+		}
+	}
+	for _, operator := range registry.Spec.Operators {
+		if operator.PackageName != "" {
+			packages[operator.PackageName] = true
+		}
+	}
+
+	csvs := map[string]bool{}
+	for _, ns := range b.installPlanNamespaces() {
+		subList := &olmv1alpha1.SubscriptionList{}
+		if err := b.Reader.List(ctx, subList, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("failed to list Subscriptions in namespace %s: %v", ns, err)
+		}
+		for _, sub := range subList.Items {
+			if sub.Spec == nil || !packages[sub.Spec.Package] || sub.Status.CurrentCSV == "" {
+				continue
+			}
+			csvs[sub.Status.CurrentCSV] = true
+		}
+	}
+	return csvs, nil
+}
+
+// listPendingInstallPlans returns the InstallPlans waiting for a manual
+// approval in the operator namespaces
+func (b *Bootstrap) listPendingInstallPlans(ctx context.Context) ([]*olmv1alpha1.InstallPlan, error) {
+	var pending []*olmv1alpha1.InstallPlan
+	for _, ns := range b.installPlanNamespaces() {
+		ipList := &olmv1alpha1.InstallPlanList{}
+		if err := b.Reader.List(ctx, ipList, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("failed to list InstallPlans in namespace %s: %v", ns, err)
+		}
+		for i := range ipList.Items {
+			ip := &ipList.Items[i]
+			if ip.Spec.Approval != olmv1alpha1.ApprovalManual || ip.Spec.Approved || ip.Status.Phase == olmv1alpha1.InstallPlanPhaseFailed {
+				continue
+			}
+			pending = append(pending, ip)
+		}
+	}
+	return pending, nil
+}
+
+// WithPinnedVersions sets the starting CSV of the operators pinned in the
+// OperatorConfigs, and keeps their InstallPlans Manual so that OLM doesn't
+// upgrade them past the pinned CSV. The other operators of the same package,
+// like the versioned ones, are not pinned.
+func WithPinnedVersions(configs []apiv3.OperatorConfig) OperandRegistryOption {
+	return func(reg *odlm.OperandRegistry) error {
+		if reg == nil {
+			return fmt.Errorf("operand registry option received nil registry")
+		}
+
+		for _, cfg := range configs {
+			if cfg.StartingCSV == "" {
+				continue
+			}
+			if _, _, err := util.ParseCSVName(cfg.StartingCSV); err != nil {
+				return fmt.Errorf("invalid startingCSV of operator %s: %v", cfg.Name, err)
+			}
+			pinned := false
+			for i := range reg.Spec.Operators {
+				operator := &reg.Spec.Operators[i]
+				if operator.Name != cfg.Name || operator.PackageName == "" {
+					continue
+				}
+				operator.StartingCSV = cfg.StartingCSV
+				operator.InstallPlanApproval = olmv1alpha1.ApprovalManual
+				pinned = true
+			}
+			if !pinned {
+				return fmt.Errorf("failed to find package name while pinning operator %s to %s", cfg.Name, cfg.StartingCSV)
+			}
+		}
+
+		return nil
+	}
+}
+
+// ApprovePinnedInstallPlans approves the pending InstallPlans of the pinned
+// CSVs. An InstallPlan is not approved when it also installs another version
+// of a pinned operator, or an operator that is not pinned while the
+// installPlanApproval is Manual
+func (b *Bootstrap) ApprovePinnedInstallPlans(ctx context.Context, instance *apiv3.CommonService) error {
+	pins := pinnedCSVs(instance.Spec.OperatorConfigs)
+	if len(pins) == 0 || !b.UsesSubscriptions() {
+		return nil
+	}
+
+	pending, err := b.listPendingInstallPlans(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ip := range pending {
+		matchedPin, approve := false, true
+		for _, csv := range ip.Spec.ClusterServiceVersionNames {
+			pinned, matched := csvPinned(pins, csv)
+			if matched {
+				matchedPin = true
+			} else if pinned || instance.Spec.InstallPlanApproval == olmv1alpha1.ApprovalManual {
+				approve = false
+			}
+		}
+		if !matchedPin {
+			continue
+		}
+		if !approve {
+			klog.Infof("InstallPlan %s/%s of pinned CSVs is not approved, it also installs CSVs %v", ip.Namespace, ip.Name, ip.Spec.ClusterServiceVersionNames)
+			continue
+		}
+
+		klog.Infof("Approving InstallPlan %s/%s for pinned CSVs %v", ip.Namespace, ip.Name, ip.Spec.ClusterServiceVersionNames)
+		ip.Spec.Approved = true
+		if err := b.Client.Update(ctx, ip); err != nil {
+			return fmt.Errorf("failed to approve InstallPlan %s/%s: %v", ip.Namespace, ip.Name, err)
+		}
+	}
+	return nil
+}
+
+// pinnedCSVs returns the pinned CSVs by the names of their operators in the
+// CSV names
+func pinnedCSVs(configs []apiv3.OperatorConfig) map[string]string {
+	pins := map[string]string{}
+	for _, cfg := range configs {
+		if cfg.StartingCSV == "" {
+			continue
+		}
+		name, _, err := util.ParseCSVName(cfg.StartingCSV)
+		if err != nil {
+			klog.Warningf("Skipping startingCSV of operator %s: %v", cfg.Name, err)
+			continue
+		}
+		pins[name] = cfg.StartingCSV
+	}
+	return pins
+}
+
+// csvPinned returns whether the operator of the CSV is pinned, and whether the
+// CSV is the pinned one
+func csvPinned(pins map[string]string, csv string) (bool, bool) {
+	name, _, err := util.ParseCSVName(csv)
+	if err != nil {
+		return false, false
+	}
+	pin, pinned := pins[name]
+	return pinned, pinned && pin == csv
+}
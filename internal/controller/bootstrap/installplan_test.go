@@ -0,0 +1,258 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func createInstallPlan(t *testing.T, bs *Bootstrap, name string, csvs ...string) {
+	t.Helper()
+	ip := &olmv1alpha1.InstallPlan{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bs.CSData.CPFSNs},
+		Spec: olmv1alpha1.InstallPlanSpec{
+			ClusterServiceVersionNames: csvs,
+			Approval:                   olmv1alpha1.ApprovalManual,
+		},
+	}
+	if err := bs.Client.Create(context.Background(), ip); err != nil {
+		t.Fatalf("failed to create the InstallPlan: %v", err)
+	}
+}
+
+func createSubscription(t *testing.T, bs *Bootstrap, name, packageName, currentCSV string) {
+	t.Helper()
+	sub := &olmv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bs.CSData.CPFSNs},
+		Spec:       &olmv1alpha1.SubscriptionSpec{Package: packageName},
+		Status:     olmv1alpha1.SubscriptionStatus{CurrentCSV: currentCSV},
+	}
+	if err := bs.Client.Create(context.Background(), sub); err != nil {
+		t.Fatalf("failed to create the Subscription: %v", err)
+	}
+}
+
+func installPlanApproved(t *testing.T, bs *Bootstrap, name string) bool {
+	t.Helper()
+	ip := &olmv1alpha1.InstallPlan{}
+	if err := bs.Client.Get(context.Background(), types.NamespacedName{Name: name, Namespace: bs.CSData.CPFSNs}, ip); err != nil {
+		t.Fatalf("failed to get the InstallPlan: %v", err)
+	}
+	return ip.Spec.Approved
+}
+
+func TestApproveInstallPlans(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := olmv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add operators/v1alpha1 to the scheme: %v", err)
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).Build()
+	bs.Reader = bs.Client
+	bs.CSData.OperatorNs = bs.CSData.CPFSNs
+	ctx := context.Background()
+
+	registry := &odlm.OperandRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs},
+		Spec:       odlm.OperandRegistrySpec{Operators: []odlm.Operator{{Name: "ibm-im-operator", PackageName: "ibm-iam-operator"}}},
+	}
+	if err := bs.Client.Create(ctx, registry); err != nil {
+		t.Fatalf("failed to create the OperandRegistry: %v", err)
+	}
+	createSubscription(t, bs, "ibm-im-operator", "ibm-iam-operator", "ibm-im-operator.v4.11.2")
+	createSubscription(t, bs, "operand-deployment-lifecycle-manager-app", "ibm-odlm", "operand-deployment-lifecycle-manager.v4.4.0")
+	createSubscription(t, bs, "other-operator", "other-operator", "other-operator.v1.0.0")
+	createInstallPlan(t, bs, "install-im", "ibm-im-operator.v4.11.2")
+	createInstallPlan(t, bs, "install-odlm", "operand-deployment-lifecycle-manager.v4.4.0")
+	createInstallPlan(t, bs, "install-other", "other-operator.v1.0.0")
+
+	// the window opens in half an hour
+	closed := fmt.Sprintf("%d * * * *", (time.Now().UTC().Minute()+30)%60)
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{
+		InstallPlanApproval: olmv1alpha1.ApprovalManual,
+		InstallPlanApprovalPolicy: &apiv3.InstallPlanApprovalPolicy{
+			MaintenanceWindow: apiv3.MaintenanceWindow{Schedule: closed, Duration: metav1.Duration{Duration: time.Minute}},
+			AllowedVersions:   []apiv3.OperatorVersionRange{{Name: "ibm-im-operator", Versions: ">=4.11.0"}},
+			ApproveTogether:   true,
+		},
+	}}
+	policy := instance.Spec.InstallPlanApprovalPolicy
+
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if status := instance.Status.InstallPlanApproval; status == nil || len(status.NotAllowed) != 2 || status.NotAllowed[0] != "operand-deployment-lifecycle-manager.v4.4.0" || status.NotAllowed[1] != "other-operator.v1.0.0" {
+		t.Fatalf("expected the ODLM CSV and the CSV outside the OperandRegistry not to be allowed, got %+v", status)
+	}
+
+	// nothing is approved outside the window, or when an InstallPlan
+	// approved together is not allowed
+	policy.AllowedVersions = append(policy.AllowedVersions, apiv3.OperatorVersionRange{Name: "operand-deployment-lifecycle-manager", Versions: ">=4.4.0"})
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	policy.AllowedVersions = policy.AllowedVersions[:1]
+	policy.MaintenanceWindow.Schedule = "* * * * *"
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if installPlanApproved(t, bs, "install-im") || installPlanApproved(t, bs, "install-odlm") {
+		t.Fatalf("expected no InstallPlan to be approved")
+	}
+
+	policy.ApproveTogether = false
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if !installPlanApproved(t, bs, "install-im") || installPlanApproved(t, bs, "install-odlm") {
+		t.Fatalf("expected only the allowed InstallPlan to be approved")
+	}
+
+	// an empty allowedVersions doesn't approve the operators outside the
+	// OperandRegistry
+	policy.AllowedVersions = nil
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if !installPlanApproved(t, bs, "install-odlm") || installPlanApproved(t, bs, "install-other") {
+		t.Fatalf("expected only the InstallPlans of the OperandRegistry and ODLM to be approved")
+	}
+	approved := instance.Status.InstallPlanApproval.Approved
+	if len(approved) != 2 || approved[0].Name != "install-im" || approved[0].ClusterServiceVersions[0] != "ibm-im-operator.v4.11.2" || approved[0].ApprovalTime == "" {
+		t.Fatalf("expected the approval of install-im to be recorded, got %+v", approved)
+	}
+
+	instance.Spec.InstallPlanApprovalPolicy = nil
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if instance.Status.InstallPlanApproval != nil {
+		t.Fatalf("expected the approval status to be removed, got %+v", instance.Status.InstallPlanApproval)
+	}
+}
+
+func TestWithPinnedVersions(t *testing.T) {
+	reg := &odlm.OperandRegistry{
+		Spec: odlm.OperandRegistrySpec{
+			Operators: []odlm.Operator{
+				{Name: "ibm-im-operator", PackageName: "ibm-iam-operator", InstallPlanApproval: olmv1alpha1.ApprovalAutomatic},
+				{Name: "ibm-im-operator-v4.2", PackageName: "ibm-iam-operator", InstallPlanApproval: olmv1alpha1.ApprovalAutomatic},
+				{Name: "ibm-platformui-operator", PackageName: "ibm-zen-operator", InstallPlanApproval: olmv1alpha1.ApprovalAutomatic},
+			},
+		},
+	}
+	configs := []apiv3.OperatorConfig{{Name: "ibm-im-operator", StartingCSV: "ibm-iam-operator.v4.12.0"}}
+
+	if err := WithPinnedVersions(configs)(reg); err != nil {
+		t.Fatalf("WithPinnedVersions returned error: %v", err)
+	}
+	for _, operator := range reg.Spec.Operators {
+		// the versioned operator of the same package is not pinned
+		pinned := operator.Name == "ibm-im-operator"
+		if pinned && (operator.StartingCSV != "ibm-iam-operator.v4.12.0" || operator.InstallPlanApproval != olmv1alpha1.ApprovalManual) {
+			t.Errorf("expected operator %s to be pinned, got %+v", operator.Name, operator)
+		}
+		if !pinned && (operator.StartingCSV != "" || operator.InstallPlanApproval != olmv1alpha1.ApprovalAutomatic) {
+			t.Errorf("expected operator %s not to be pinned, got %+v", operator.Name, operator)
+		}
+	}
+
+	if err := WithPinnedVersions([]apiv3.OperatorConfig{{Name: "ibm-im-operator", StartingCSV: "ibm-iam-operator"}})(reg); err == nil {
+		t.Fatalf("expected an error for a startingCSV without a version")
+	}
+}
+
+func TestApprovePinnedInstallPlans(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := olmv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add operators/v1alpha1 to the scheme: %v", err)
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).Build()
+	bs.Reader = bs.Client
+	bs.CSData.OperatorNs = bs.CSData.CPFSNs
+	ctx := context.Background()
+
+	createInstallPlan(t, bs, "install-pinned", "ibm-iam-operator.v4.12.0")
+	createInstallPlan(t, bs, "install-upgrade", "ibm-iam-operator.v4.13.0")
+	createInstallPlan(t, bs, "install-together", "ibm-iam-operator.v4.12.0", "ibm-zen-operator.v6.2.0")
+	createInstallPlan(t, bs, "install-other", "ibm-zen-operator.v6.2.0")
+
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{
+		InstallPlanApproval: olmv1alpha1.ApprovalManual,
+		OperatorConfigs:     []apiv3.OperatorConfig{{Name: "ibm-im-operator", StartingCSV: "ibm-iam-operator.v4.12.0"}},
+	}}
+	if err := bs.ApprovePinnedInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApprovePinnedInstallPlans returned error: %v", err)
+	}
+	for name, approved := range map[string]bool{
+		"install-pinned":   true,
+		"install-upgrade":  false,
+		"install-together": false,
+		"install-other":    false,
+	} {
+		if installPlanApproved(t, bs, name) != approved {
+			t.Errorf("expected the approval of InstallPlan %s to be %v", name, approved)
+		}
+	}
+
+	// the CSVs of the operators not pinned are approved with the pinned CSV
+	// when the installPlanApproval is Automatic
+	instance.Spec.InstallPlanApproval = olmv1alpha1.ApprovalAutomatic
+	if err := bs.ApprovePinnedInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApprovePinnedInstallPlans returned error: %v", err)
+	}
+	if !installPlanApproved(t, bs, "install-together") || installPlanApproved(t, bs, "install-upgrade") || installPlanApproved(t, bs, "install-other") {
+		t.Fatalf("expected only the InstallPlans with the pinned CSV to be approved")
+	}
+
+	// the approval policy doesn't upgrade a pinned operator
+	registry := &odlm.OperandRegistry{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs},
+		Spec: odlm.OperandRegistrySpec{Operators: []odlm.Operator{
+			{Name: "ibm-im-operator", PackageName: "ibm-iam-operator"},
+			{Name: "ibm-platformui-operator", PackageName: "ibm-zen-operator"},
+		}},
+	}
+	if err := bs.Client.Create(ctx, registry); err != nil {
+		t.Fatalf("failed to create the OperandRegistry: %v", err)
+	}
+	createSubscription(t, bs, "ibm-im-operator", "ibm-iam-operator", "ibm-iam-operator.v4.13.0")
+	createSubscription(t, bs, "ibm-platformui-operator", "ibm-zen-operator", "ibm-zen-operator.v6.2.0")
+	instance.Spec.InstallPlanApproval = olmv1alpha1.ApprovalManual
+	instance.Spec.InstallPlanApprovalPolicy = &apiv3.InstallPlanApprovalPolicy{
+		MaintenanceWindow: apiv3.MaintenanceWindow{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Minute}},
+	}
+	if err := bs.ApproveInstallPlans(ctx, instance); err != nil {
+		t.Fatalf("ApproveInstallPlans returned error: %v", err)
+	}
+	if installPlanApproved(t, bs, "install-upgrade") || !installPlanApproved(t, bs, "install-other") {
+		t.Fatalf("expected the approval policy to skip the upgrade of the pinned operator")
+	}
+}
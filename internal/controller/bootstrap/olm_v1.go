@@ -0,0 +1,226 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// olmV1Backend installs ODLM with an OLM v1 ClusterExtension. ODLM is
+// installed from the ClusterCatalog of this operator with its ServiceAccount,
+// and the approval mode Manual pins the installed versions. ODLM still
+// installs the other operators with OLM Subscriptions.
+type olmV1Backend struct {
+	*Bootstrap
+}
+
+func (o *olmV1Backend) Mode() string {
+	return constant.InstallModeOLMv1
+}
+
+func (o *olmV1Backend) ResolveCatalog(ctx context.Context) (string, string, error) {
+	ext, err := o.operatorClusterExtension(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	// a ClusterCatalog is cluster scoped, the package is resolved from all
+	// the catalogs when it is not selected
+	catalog, _, _ := unstructured.NestedString(ext.Object, "spec", "source", "catalog", "selector", "matchLabels", constant.ClusterCatalogLabel)
+	return catalog, "", nil
+}
+
+func (o *olmV1Backend) ApprovalMode(ctx context.Context) (string, error) {
+	ext, err := o.operatorClusterExtension(ctx)
+	if err != nil {
+		return "", err
+	}
+	if version, _, _ := unstructured.NestedString(ext.Object, "spec", "source", "catalog", "version"); version != "" {
+		return string(olmv1alpha1.ApprovalManual), nil
+	}
+	return string(olmv1alpha1.ApprovalAutomatic), nil
+}
+
+func (o *olmV1Backend) UpdateApproval(ctx context.Context) error {
+	if o.CSData.ApprovalMode != string(olmv1alpha1.ApprovalManual) {
+		return nil
+	}
+	ext, err := o.operatorClusterExtension(ctx)
+	if err != nil {
+		return err
+	}
+	if pinInstalledVersion(ext) {
+		klog.Infof("Pinning ClusterExtension %s to its installed version", ext.GetName())
+		return o.Client.Update(ctx, ext)
+	}
+	return nil
+}
+
+func (o *olmV1Backend) InstallODLM(ctx context.Context, instance *apiv3.CommonService) (bool, error) {
+	operatorExt, err := o.operatorClusterExtension(ctx)
+	if err != nil {
+		return false, err
+	}
+	serviceAccount, _, _ := unstructured.NestedString(operatorExt.Object, "spec", "serviceAccount", "name")
+
+	ext := &unstructured.Unstructured{}
+	ext.SetAPIVersion(constant.ClusterExtensionAPIVersion)
+	ext.SetKind("ClusterExtension")
+	name := fmt.Sprintf("%s-%s", constant.IBMODLMPackage, o.CSData.CPFSNs)
+	exists := true
+	if err := o.Reader.Get(ctx, types.NamespacedName{Name: name}, ext); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+		exists = false
+		ext.SetName(name)
+	}
+
+	desired := map[string]interface{}{
+		"namespace":      o.CSData.CPFSNs,
+		"serviceAccount": map[string]interface{}{"name": serviceAccount},
+		"source": map[string]interface{}{
+			"sourceType": "Catalog",
+			"catalog": map[string]interface{}{
+				"packageName": constant.ODLMPackageName,
+				"channels":    []interface{}{o.CSData.ODLMChannel},
+			},
+		},
+	}
+	if o.CSData.ODLMCatalogSourceName != "" {
+		desired["source"].(map[string]interface{})["catalog"].(map[string]interface{})["selector"] = map[string]interface{}{
+			"matchLabels": map[string]interface{}{constant.ClusterCatalogLabel: o.CSData.ODLMCatalogSourceName},
+		}
+	}
+	// keep the pinned version of ODLM
+	if version, _, _ := unstructured.NestedString(ext.Object, "spec", "source", "catalog", "version"); version != "" {
+		desired["source"].(map[string]interface{})["catalog"].(map[string]interface{})["version"] = version
+	}
+	if err := unstructured.SetNestedMap(ext.Object, desired, "spec"); err != nil {
+		return false, err
+	}
+	if o.CSData.ApprovalMode == string(olmv1alpha1.ApprovalManual) {
+		pinInstalledVersion(ext)
+	}
+
+	if exists {
+		klog.Info("Updating ODLM ClusterExtension")
+		err = o.Client.Update(ctx, ext)
+	} else {
+		klog.Info("Installing ODLM ClusterExtension")
+		err = o.Client.Create(ctx, ext)
+	}
+	if err != nil {
+		return false, err
+	}
+	return !clusterExtensionInstalled(ext), nil
+}
+
+func (o *olmV1Backend) OperatorStatus(ctx context.Context, instance *apiv3.CommonService, operator *odlm.Operator) (apiv3.BedrockOperator, error) {
+	opt := apiv3.BedrockOperator{Name: operator.Name}
+	ext, err := o.clusterExtension(ctx, operator.PackageName, operator.Namespace)
+	if err != nil {
+		return opt, err
+	}
+	if ext == nil {
+		// the operators installed by ODLM have a Subscription
+		return o.setOperatorStatus(instance, operator.Name, operator.PackageName, operator.Namespace)
+	}
+
+	opt.OperatorStatus, opt.SubscriptionStatus = apiv3.CRNotReady, apiv3.CRNotReady
+	if bundle, _, _ := unstructured.NestedString(ext.Object, "status", "install", "bundle", "name"); strings.Contains(bundle, ".") {
+		opt.Name = bundle[:strings.IndexByte(bundle, '.')]
+		opt.Version = bundle[strings.IndexByte(bundle, '.')+1:]
+	}
+	if clusterExtensionInstalled(ext) {
+		opt.OperatorStatus, opt.SubscriptionStatus = apiv3.CRSucceeded, apiv3.CRSucceeded
+	} else {
+		opt.Troubleshooting = "Operator status is not healthy, please check " + constant.GeneralTroubleshooting + " for more information"
+	}
+	return opt, nil
+}
+
+func (o *olmV1Backend) UsesSubscriptions() bool {
+	return true
+}
+
+// operatorClusterExtension returns the ClusterExtension installing this
+// operator
+func (o *olmV1Backend) operatorClusterExtension(ctx context.Context) (*unstructured.Unstructured, error) {
+	ext, err := o.clusterExtension(ctx, constant.IBMCSPackage, o.CSData.OperatorNs)
+	if err != nil {
+		return nil, err
+	}
+	if ext == nil {
+		return nil, fmt.Errorf("no ClusterExtension found by package %s and namespace %s", constant.IBMCSPackage, o.CSData.OperatorNs)
+	}
+	return ext, nil
+}
+
+// clusterExtension returns the ClusterExtension installing the package into
+// the namespace, it returns nil when it is not found
+func (o *olmV1Backend) clusterExtension(ctx context.Context, packageName, namespace string) (*unstructured.Unstructured, error) {
+	extList := &unstructured.UnstructuredList{}
+	extList.SetAPIVersion(constant.ClusterExtensionAPIVersion)
+	extList.SetKind("ClusterExtensionList")
+	if err := o.Reader.List(ctx, extList); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterExtensions: %v", err)
+	}
+	for i := range extList.Items {
+		ext := &extList.Items[i]
+		extPackage, _, _ := unstructured.NestedString(ext.Object, "spec", "source", "catalog", "packageName")
+		extNamespace, _, _ := unstructured.NestedString(ext.Object, "spec", "namespace")
+		if extPackage == packageName && extNamespace == namespace {
+			return ext, nil
+		}
+	}
+	return nil, nil
+}
+
+// clusterExtensionInstalled returns true when the bundle of the
+// ClusterExtension is installed
+func clusterExtensionInstalled(ext *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(ext.Object, "status", "conditions")
+	for _, item := range conditions {
+		if condition, ok := item.(map[string]interface{}); ok && condition["type"] == "Installed" {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+// pinInstalledVersion pins the ClusterExtension to its installed version, it
+// returns true when the version is changed
+func pinInstalledVersion(ext *unstructured.Unstructured) bool {
+	installed, _, _ := unstructured.NestedString(ext.Object, "status", "install", "bundle", "version")
+	pinned, _, _ := unstructured.NestedString(ext.Object, "spec", "source", "catalog", "version")
+	if installed == "" || pinned != "" {
+		return false
+	}
+	return unstructured.SetNestedField(ext.Object, installed, "spec", "source", "catalog", "version") == nil
+}
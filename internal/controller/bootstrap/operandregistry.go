@@ -66,8 +66,80 @@ func WithUserManagedOverridesFromConfigs(configs []apiv3.OperatorConfig) Operand
 	return WithUserManagedOverrides(overrides, resetAll)
 }
 
+// WithPodPlacement applies the pod placement of the CommonService spec to the
+// Subscription config of each operator. The Subscription config only supports
+// the node selector and tolerations, the affinity and topology spread
+// constraints only apply to the operands.
+func WithPodPlacement(placement *apiv3.Placement) OperandRegistryOption {
+	return func(reg *odlm.OperandRegistry) error {
+		if reg == nil {
+			return fmt.Errorf("operand registry option received nil registry")
+		}
+		if placement == nil {
+			return nil
+		}
+
+		for i := range reg.Spec.Operators {
+			operator := &reg.Spec.Operators[i]
+			podPlacement := placement.ForService(operator.Name)
+			if podPlacement.NodeSelector == nil && podPlacement.Tolerations == nil {
+				continue
+			}
+			if operator.SubscriptionConfig == nil {
+				operator.SubscriptionConfig = &olmv1alpha1.SubscriptionConfig{}
+			}
+			if podPlacement.NodeSelector != nil {
+				operator.SubscriptionConfig.NodeSelector = podPlacement.NodeSelector
+			}
+			if podPlacement.Tolerations != nil {
+				operator.SubscriptionConfig.Tolerations = podPlacement.Tolerations
+			}
+		}
+
+		return nil
+	}
+}
+
 // buildOperandRegistry renders the desired OperandRegistry spec based on the current bootstrap data.
 func (b *Bootstrap) buildOperandRegistry(ctx context.Context, installPlanApproval olmv1alpha1.Approval, options ...OperandRegistryOption) (*odlm.OperandRegistry, error) {
+	desired, err := b.renderOperandRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the channels available in the catalogs
+	if b.UsesSubscriptions() {
+		if err := b.resolveChannels(ctx, desired); err != nil {
+			klog.Warningf("Failed to resolve channels of OperandRegistry: %v", err)
+		}
+	}
+
+	// honour explicit install plan approval overrides
+	approvalMode := installPlanApproval
+	if approvalMode == "" {
+		approvalMode = olmv1alpha1.Approval(b.CSData.ApprovalMode)
+	}
+	if approvalMode != "" {
+		for i := range desired.Spec.Operators {
+			desired.Spec.Operators[i].InstallPlanApproval = approvalMode
+		}
+	}
+
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		if err := opt(desired); err != nil {
+			return nil, err
+		}
+	}
+
+	return desired, nil
+}
+
+// renderOperandRegistry renders the OperandRegistry from the templates, and
+// applies the template overlays and the custom operators
+func (b *Bootstrap) renderOperandRegistry(ctx context.Context) (*odlm.OperandRegistry, error) {
 	configMap := &corev1.ConfigMap{}
 	if err := b.Client.Get(ctx, types.NamespacedName{
 		Name:      constant.IBMCPPCONFIG,
@@ -81,17 +153,7 @@ func (b *Bootstrap) buildOperandRegistry(ctx context.Context, installPlanApprova
 	}
 
 	var baseReg string
-	registries := []string{
-		constant.CSV4OpReg,
-		constant.MongoDBOpReg,
-		constant.IMOpReg,
-		constant.IdpConfigUIOpReg,
-		constant.PlatformUIOpReg,
-		constant.KeyCloakOpReg,
-		constant.CommonServicePGOpReg,
-		constant.CommonServiceCNPGOpReg,
-		constant.CommonServicePGMigratorOpReg,
-	}
+	registries := common.GetBaseOperandRegistryList()
 
 	if b.SaasEnable {
 		baseReg = constant.CSV3SaasOpReg
@@ -121,26 +183,8 @@ func (b *Bootstrap) buildOperandRegistry(ctx context.Context, installPlanApprova
 	}
 	desired.Labels[constant.CsManagedLabel] = "true"
 
-	// honour explicit install plan approval overrides
-	approvalMode := installPlanApproval
-	if approvalMode == "" {
-		approvalMode = olmv1alpha1.Approval(b.CSData.ApprovalMode)
-	}
-	if approvalMode != "" {
-		for i := range desired.Spec.Operators {
-			desired.Spec.Operators[i].InstallPlanApproval = approvalMode
-		}
-	}
-
-	for _, opt := range options {
-		if opt == nil {
-			continue
-		}
-		if err := opt(desired); err != nil {
-			return nil, err
-		}
-	}
-
+	// apply the site overlays before the channels of the operators are resolved
+	b.applyRegistryOverlays(desired)
 	return desired, nil
 }
 
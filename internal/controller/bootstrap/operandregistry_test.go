@@ -2,6 +2,7 @@ package bootstrap
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -143,3 +144,89 @@ func TestInstallOrUpdateOpregWithUserManagedOverrides(t *testing.T) {
 		}
 	}
 }
+
+func TestInstallOrUpdateOpregWithPodPlacement(t *testing.T) {
+	t.Parallel()
+
+	bs := buildTestBootstrap(t)
+	ctx := context.Background()
+
+	tolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "cpfs", Effect: corev1.TaintEffectNoSchedule}}
+	placement := &apiv3.Placement{
+		PodPlacement: apiv3.PodPlacement{
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			Tolerations:  tolerations,
+		},
+		Services: []apiv3.ServicePlacement{{
+			Name:         "common-service-postgresql",
+			PodPlacement: apiv3.PodPlacement{NodeSelector: map[string]string{"disktype": "ssd"}},
+		}},
+	}
+
+	if err := bs.InstallOrUpdateOpreg(ctx, "", WithPodPlacement(placement)); err != nil {
+		t.Fatalf("InstallOrUpdateOpreg returned error: %v", err)
+	}
+
+	opreg := &odlm.OperandRegistry{}
+	if err := bs.Client.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs}, opreg); err != nil {
+		t.Fatalf("expected OperandRegistry to exist: %v", err)
+	}
+
+	for _, operator := range opreg.Spec.Operators {
+		if operator.SubscriptionConfig == nil {
+			t.Fatalf("expected subscription config for operator %s", operator.Name)
+		}
+		if len(operator.SubscriptionConfig.Tolerations) != 1 || operator.SubscriptionConfig.Tolerations[0].Key != "dedicated" {
+			t.Fatalf("expected global tolerations for operator %s, got %v", operator.Name, operator.SubscriptionConfig.Tolerations)
+		}
+		expected := "node-role.kubernetes.io/infra"
+		if operator.Name == "common-service-postgresql" {
+			expected = "disktype"
+		}
+		if _, ok := operator.SubscriptionConfig.NodeSelector[expected]; !ok || len(operator.SubscriptionConfig.NodeSelector) != 1 {
+			t.Fatalf("expected node selector %s for operator %s, got %v", expected, operator.Name, operator.SubscriptionConfig.NodeSelector)
+		}
+	}
+}
+
+func TestInstallOrUpdateOpregWithProxy(t *testing.T) {
+	t.Parallel()
+
+	bs := buildTestBootstrap(t)
+	ctx := context.Background()
+
+	proxy := &apiv3.Proxy{HTTPProxy: "http://proxy.example.com:3128", HTTPSProxy: "http://proxy.example.com:3128"}
+	existingEnv := func(reg *odlm.OperandRegistry) error {
+		for i := range reg.Spec.Operators {
+			reg.Spec.Operators[i].SubscriptionConfig = &olmv1alpha1.SubscriptionConfig{
+				Env: []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}, {Name: "HTTP_PROXY", Value: "http://old.example.com"}},
+			}
+		}
+		return nil
+	}
+
+	if err := bs.InstallOrUpdateOpreg(ctx, "", existingEnv, WithProxy(proxy, bs.CSData.ServicesNs)); err != nil {
+		t.Fatalf("InstallOrUpdateOpreg returned error: %v", err)
+	}
+
+	opreg := &odlm.OperandRegistry{}
+	if err := bs.Client.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs}, opreg); err != nil {
+		t.Fatalf("expected OperandRegistry to exist: %v", err)
+	}
+
+	for _, operator := range opreg.Spec.Operators {
+		env := map[string]string{}
+		for _, v := range operator.SubscriptionConfig.Env {
+			env[v.Name] = v.Value
+		}
+		if len(operator.SubscriptionConfig.Env) != 4 {
+			t.Fatalf("expected 4 env variables for operator %s, got %v", operator.Name, operator.SubscriptionConfig.Env)
+		}
+		if env["LOG_LEVEL"] != "debug" || env["HTTP_PROXY"] != proxy.HTTPProxy || env["HTTPS_PROXY"] != proxy.HTTPSProxy {
+			t.Fatalf("unexpected env for operator %s: %v", operator.Name, env)
+		}
+		if !strings.Contains(env["NO_PROXY"], ".ibm-common-services.svc") {
+			t.Fatalf("expected NO_PROXY to contain the services namespace for operator %s: %s", operator.Name, env["NO_PROXY"])
+		}
+	}
+}
@@ -0,0 +1,361 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	utilyaml "github.com/ghodss/yaml"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// Keys of a template overlay ConfigMap
+const (
+	overlayOperandRegistryKey = "operandRegistry"
+	overlayOperandConfigKey   = "operandConfig"
+)
+
+// objectOverlay adds, patches and removes the operators of the OperandRegistry
+// or the services of the OperandConfig by their names
+type objectOverlay struct {
+	Add    []map[string]interface{} `json:"add,omitempty"`
+	Patch  []map[string]interface{} `json:"patch,omitempty"`
+	Remove []string                 `json:"remove,omitempty"`
+}
+
+// templateOverlay is a site overlay of the OperandRegistry and the
+// OperandConfig rendered from the templates
+type templateOverlay struct {
+	// source is the kind and the name of the overlay, e.g. ConfigMap ns/name
+	source   string
+	registry objectOverlay
+	config   objectOverlay
+}
+
+// LoadTemplateOverlays loads the template overlays from the labelled
+// ConfigMaps in the operator namespace, they are applied in the order of the
+// ConfigMap names. Invalid overlays are skipped and reported as warnings on
+// the master CommonService CR.
+func (b *Bootstrap) LoadTemplateOverlays(ctx context.Context) error {
+	cmList := &corev1.ConfigMapList{}
+	if err := b.Client.List(ctx, cmList, client.InNamespace(b.CSData.OperatorNs), client.MatchingLabels{
+		constant.CsManagedLabel:       "true",
+		constant.TemplateOverlayLabel: "true",
+	}); err != nil {
+		return fmt.Errorf("failed to list template overlay ConfigMaps: %v", err)
+	}
+	sort.Slice(cmList.Items, func(i, j int) bool {
+		return cmList.Items[i].Name < cmList.Items[j].Name
+	})
+
+	var overlays []templateOverlay
+	var overlayErrs []error
+	for i := range cmList.Items {
+		overlay, err := templateOverlayFromConfigMap(&cmList.Items[i], b.CSData)
+		if err != nil {
+			overlayErrs = append(overlayErrs, err)
+			continue
+		}
+		overlays = append(overlays, overlay)
+	}
+
+	for _, err := range overlayErrs {
+		klog.Warningf("Skipping template overlay: %v", err)
+	}
+	klog.V(2).Infof("Loaded %d template overlay ConfigMaps, %d skipped", len(cmList.Items), len(overlayErrs))
+	b.templateOverlays = overlays
+	b.templateOverlayErrs = overlayErrs
+	return nil
+}
+
+// templateOverlayFromConfigMap renders a template overlay ConfigMap with the
+// template data and validates the operators and services it adds
+func templateOverlayFromConfigMap(cm *corev1.ConfigMap, data apiv3.CSData) (templateOverlay, error) {
+	overlay := templateOverlay{source: "ConfigMap " + cm.Namespace + "/" + cm.Name}
+	for key, target := range map[string]*objectOverlay{
+		overlayOperandRegistryKey: &overlay.registry,
+		overlayOperandConfigKey:   &overlay.config,
+	} {
+		value, ok := cm.Data[key]
+		if !ok {
+			continue
+		}
+		rendered, err := constant.RenderTemplate(value, data)
+		if err != nil {
+			return overlay, fmt.Errorf("%s has an invalid %s template: %v", overlay.source, key, err)
+		}
+		if err := strictUnmarshal(rendered, target); err != nil {
+			return overlay, fmt.Errorf("%s has an invalid %s: %v", overlay.source, key, err)
+		}
+	}
+	if err := overlay.validate(); err != nil {
+		return overlay, fmt.Errorf("%s: %v", overlay.source, err)
+	}
+	return overlay, nil
+}
+
+// validate checks the overlay before it is applied, the operators and the
+// services it adds must be valid ODLM objects
+func (o *templateOverlay) validate() error {
+	if _, err := toOperators(o.registry.Add); err != nil {
+		return fmt.Errorf("invalid operator to add: %v", err)
+	}
+	if _, err := toConfigServices(o.config.Add); err != nil {
+		return fmt.Errorf("invalid service to add: %v", err)
+	}
+	for _, overlay := range []objectOverlay{o.registry, o.config} {
+		for _, patch := range overlay.Patch {
+			if name, _ := patch["name"].(string); name == "" {
+				return fmt.Errorf("patch %v has no name", patch)
+			}
+		}
+		for _, name := range overlay.Remove {
+			if name == "" {
+				return fmt.Errorf("empty name to remove")
+			}
+		}
+	}
+	return nil
+}
+
+// applyRegistryOverlays applies the template overlays to the operators of the
+// OperandRegistry, an overlay that can't be applied is skipped
+func (b *Bootstrap) applyRegistryOverlays(registry *odlm.OperandRegistry) {
+	for _, overlay := range b.overlays() {
+		items, err := toItems(registry.Spec.Operators)
+		if err == nil {
+			items, err = applyObjectOverlay(items, overlay.registry)
+		}
+		var operators []odlm.Operator
+		if err == nil {
+			operators, err = toOperators(items)
+		}
+		if err != nil {
+			b.addTemplateOverlayErr(fmt.Errorf("%s is not applied to OperandRegistry: %v", overlay.source, err))
+			continue
+		}
+		registry.Spec.Operators = operators
+	}
+}
+
+// ApplyOperandConfigOverlays applies the template overlays to the services of
+// the OperandConfig rendered from the templates, an overlay that can't be
+// applied is skipped
+func (b *Bootstrap) ApplyOperandConfigOverlays(opcon string) (string, error) {
+	if len(b.overlays()) == 0 {
+		return opcon, nil
+	}
+	config := &odlm.OperandConfig{}
+	if err := utilyaml.Unmarshal([]byte(opcon), config); err != nil {
+		return "", fmt.Errorf("failed to unmarshal OperandConfig template: %v", err)
+	}
+	for _, overlay := range b.overlays() {
+		items, err := toItems(config.Spec.Services)
+		if err == nil {
+			items, err = applyObjectOverlay(items, overlay.config)
+		}
+		var services []odlm.ConfigService
+		if err == nil {
+			services, err = toConfigServices(items)
+		}
+		if err != nil {
+			b.addTemplateOverlayErr(fmt.Errorf("%s is not applied to OperandConfig: %v", overlay.source, err))
+			continue
+		}
+		config.Spec.Services = services
+	}
+	opconBytes, err := utilyaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(opconBytes), nil
+}
+
+// overlays returns the template overlays followed by the custom operators
+func (b *Bootstrap) overlays() []templateOverlay {
+	return append(append([]templateOverlay{}, b.templateOverlays...), b.customOperators...)
+}
+
+// addTemplateOverlayErr records an overlay that can't be applied, the
+// templates are rendered several times in a reconciliation
+func (b *Bootstrap) addTemplateOverlayErr(err error) {
+	for _, overlayErr := range b.templateOverlayErrs {
+		if overlayErr.Error() == err.Error() {
+			return
+		}
+	}
+	klog.Warningf("Skipping template overlay: %v", err)
+	b.templateOverlayErrs = append(b.templateOverlayErrs, err)
+}
+
+// applyObjectOverlay removes, patches and then adds the items by their names
+func applyObjectOverlay(items []map[string]interface{}, overlay objectOverlay) ([]map[string]interface{}, error) {
+	indexOf := func(name string) int {
+		for i, item := range items {
+			if item["name"] == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, name := range overlay.Remove {
+		i := indexOf(name)
+		if i < 0 {
+			return nil, fmt.Errorf("%s to remove is not found", name)
+		}
+		items = append(items[:i], items[i+1:]...)
+	}
+	for _, patch := range overlay.Patch {
+		name, _ := patch["name"].(string)
+		i := indexOf(name)
+		if i < 0 {
+			return nil, fmt.Errorf("%s to patch is not found", name)
+		}
+		items[i] = mergePatch(items[i], patch)
+	}
+	for _, item := range overlay.Add {
+		name, _ := item["name"].(string)
+		if indexOf(name) >= 0 {
+			return nil, fmt.Errorf("%s to add already exists", name)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// mergePatch merges the patch into the item like a JSON merge patch, nested
+// maps are merged and a null value removes a field
+func mergePatch(item, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(item))
+	for key, value := range item {
+		merged[key] = value
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		patchMap, isMap := value.(map[string]interface{})
+		itemMap, wasMap := merged[key].(map[string]interface{})
+		if isMap && wasMap {
+			merged[key] = mergePatch(itemMap, patchMap)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// toItems converts the ODLM objects into maps to apply an overlay
+func toItems(objects interface{}) ([]map[string]interface{}, error) {
+	raw, err := json.Marshal(objects)
+	if err != nil {
+		return nil, err
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// toOperators converts the overlay items into valid OperandRegistry operators
+func toOperators(items []map[string]interface{}) ([]odlm.Operator, error) {
+	operators := []odlm.Operator{}
+	if err := convertItems(items, &operators); err != nil {
+		return nil, err
+	}
+	for _, operator := range operators {
+		if operator.Name == "" || operator.PackageName == "" {
+			return nil, fmt.Errorf("operator %q needs a name and a packageName", operator.Name)
+		}
+		if !util.Contains([]string{"", "public", "private"}, string(operator.Scope)) {
+			return nil, fmt.Errorf("operator %s has an invalid scope %q", operator.Name, operator.Scope)
+		}
+		if !util.Contains([]string{"", "cluster", "namespace", "no-op"}, operator.InstallMode) {
+			return nil, fmt.Errorf("operator %s has an invalid installMode %q", operator.Name, operator.InstallMode)
+		}
+		if !util.Contains([]string{"", string(olmv1alpha1.ApprovalAutomatic), string(olmv1alpha1.ApprovalManual)}, string(operator.InstallPlanApproval)) {
+			return nil, fmt.Errorf("operator %s has an invalid installPlanApproval %q", operator.Name, operator.InstallPlanApproval)
+		}
+	}
+	return operators, nil
+}
+
+// toConfigServices converts the overlay items into valid OperandConfig services
+func toConfigServices(items []map[string]interface{}) ([]odlm.ConfigService, error) {
+	services := []odlm.ConfigService{}
+	if err := convertItems(items, &services); err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		if service.Name == "" {
+			return nil, fmt.Errorf("service needs a name")
+		}
+		for _, resource := range service.Resources {
+			if resource.Name == "" || resource.Kind == "" || resource.APIVersion == "" {
+				return nil, fmt.Errorf("resource %q of service %s needs a name, a kind and an apiVersion", resource.Name, service.Name)
+			}
+		}
+	}
+	return services, nil
+}
+
+// convertItems decodes the items into ODLM objects, unknown fields are not
+// allowed
+func convertItems(items []map[string]interface{}, objects interface{}) error {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return strictUnmarshalJSON(raw, objects)
+}
+
+// strictUnmarshal decodes YAML into the object, unknown fields are not allowed
+func strictUnmarshal(data []byte, object interface{}) error {
+	raw, err := utilyaml.YAMLToJSON(data)
+	if err != nil {
+		return err
+	}
+	return strictUnmarshalJSON(raw, object)
+}
+
+func strictUnmarshalJSON(raw []byte, object interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(object)
+}
+
+// checkTemplateOverlayWarning sets a warning for each skipped template overlay
+func (b *Bootstrap) checkTemplateOverlayWarning(instance *apiv3.CommonService) {
+	for _, err := range b.templateOverlayErrs {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageInvalidTemplateOverlay, err))
+	}
+}
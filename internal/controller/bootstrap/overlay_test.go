@@ -0,0 +1,233 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	utilyaml "github.com/ghodss/yaml"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func newTemplateOverlayConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ibm-common-services",
+			Labels: map[string]string{
+				constant.CsManagedLabel:       "true",
+				constant.TemplateOverlayLabel: "true",
+			},
+		},
+		Data: data,
+	}
+}
+
+func buildOverlayBootstrap(t *testing.T, objs ...*corev1.ConfigMap) *Bootstrap {
+	t.Helper()
+
+	bs := buildTestBootstrap(t)
+	bs.CSData.OperatorNs = "ibm-common-services"
+	builder := fake.NewClientBuilder().WithScheme(bs.Client.Scheme())
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj)
+	}
+	bs.Client = builder.Build()
+	bs.Reader = bs.Client
+	return bs
+}
+
+func findOperator(operators []odlm.Operator, name string) *odlm.Operator {
+	for i := range operators {
+		if operators[i].Name == name {
+			return &operators[i]
+		}
+	}
+	return nil
+}
+
+func TestApplyRegistryOverlays(t *testing.T) {
+	bs := buildOverlayBootstrap(t, newTemplateOverlayConfigMap("site", map[string]string{
+		"operandRegistry": `
+add:
+- name: site-operator
+  packageName: site-operator-app
+  namespace: "{{ .ServicesNs }}"
+  channel: v1
+  installMode: namespace
+patch:
+- name: ibm-licensing-operator
+  channel: v4.0
+  installMode: null
+remove:
+- ibm-mongodb-operator
+`,
+	}))
+	ctx := context.Background()
+
+	if err := bs.LoadTemplateOverlays(ctx); err != nil {
+		t.Fatalf("LoadTemplateOverlays returned error: %v", err)
+	}
+	if len(bs.templateOverlayErrs) != 0 {
+		t.Fatalf("unexpected overlay errors: %v", bs.templateOverlayErrs)
+	}
+	registry, err := bs.buildOperandRegistry(ctx, olmv1alpha1.ApprovalAutomatic)
+	if err != nil {
+		t.Fatalf("buildOperandRegistry returned error: %v", err)
+	}
+
+	added := findOperator(registry.Spec.Operators, "site-operator")
+	if added == nil || added.Namespace != "ibm-common-services" || added.PackageName != "site-operator-app" {
+		t.Fatalf("expected site-operator to be added with the rendered namespace, got %+v", added)
+	}
+	patched := findOperator(registry.Spec.Operators, "ibm-licensing-operator")
+	if patched == nil || patched.Channel != "v4.0" || patched.InstallMode != "" || patched.PackageName != "ibm-licensing-operator-app" {
+		t.Fatalf("expected ibm-licensing-operator to be patched, got %+v", patched)
+	}
+	if findOperator(registry.Spec.Operators, "ibm-mongodb-operator") != nil {
+		t.Fatalf("expected ibm-mongodb-operator to be removed")
+	}
+}
+
+func TestApplyRegistryOverlaysSkipsConflicts(t *testing.T) {
+	bs := buildOverlayBootstrap(t,
+		newTemplateOverlayConfigMap("a-duplicate", map[string]string{
+			"operandRegistry": "add:\n- name: ibm-licensing-operator\n  packageName: other\n",
+		}),
+		newTemplateOverlayConfigMap("b-unknown", map[string]string{
+			"operandRegistry": "remove:\n- not-an-operator\n",
+		}),
+		newTemplateOverlayConfigMap("c-valid", map[string]string{
+			"operandRegistry": "patch:\n- name: ibm-licensing-operator\n  channel: v4.0\n",
+		}),
+	)
+	ctx := context.Background()
+
+	if err := bs.LoadTemplateOverlays(ctx); err != nil {
+		t.Fatalf("LoadTemplateOverlays returned error: %v", err)
+	}
+	registry, err := bs.buildOperandRegistry(ctx, olmv1alpha1.ApprovalAutomatic)
+	if err != nil {
+		t.Fatalf("buildOperandRegistry returned error: %v", err)
+	}
+	// rendering again doesn't report the same errors twice
+	if _, err := bs.buildOperandRegistry(ctx, olmv1alpha1.ApprovalAutomatic); err != nil {
+		t.Fatalf("buildOperandRegistry returned error: %v", err)
+	}
+
+	if len(bs.templateOverlayErrs) != 2 {
+		t.Fatalf("expected 2 overlay errors, got %v", bs.templateOverlayErrs)
+	}
+	if !strings.Contains(bs.templateOverlayErrs[0].Error(), "ibm-licensing-operator to add already exists") {
+		t.Errorf("unexpected error: %v", bs.templateOverlayErrs[0])
+	}
+	if !strings.Contains(bs.templateOverlayErrs[1].Error(), "not-an-operator to remove is not found") {
+		t.Errorf("unexpected error: %v", bs.templateOverlayErrs[1])
+	}
+	licensing := findOperator(registry.Spec.Operators, "ibm-licensing-operator")
+	if licensing == nil || licensing.Channel != "v4.0" || licensing.PackageName != "ibm-licensing-operator-app" {
+		t.Fatalf("expected the valid overlay to be applied, got %+v", licensing)
+	}
+
+	instance := &apiv3.CommonService{}
+	bs.checkTemplateOverlayWarning(instance)
+	if len(instance.Status.Conditions) == 0 {
+		t.Fatalf("expected a warning condition for the skipped overlays")
+	}
+}
+
+func TestTemplateOverlayFromConfigMapValidation(t *testing.T) {
+	tests := map[string]map[string]string{
+		"unknown field":         {"operandRegistry": "add:\n- name: a\n  packageName: a\n  chanel: v1\n"},
+		"missing packageName":   {"operandRegistry": "add:\n- name: a\n"},
+		"invalid installMode":   {"operandRegistry": "add:\n- name: a\n  packageName: a\n  installMode: all\n"},
+		"invalid approval":      {"operandRegistry": "add:\n- name: a\n  packageName: a\n  installPlanApproval: Always\n"},
+		"patch without name":    {"operandConfig": "patch:\n- spec: {}\n"},
+		"resource without kind": {"operandConfig": "add:\n- name: a\n  resources:\n  - name: r\n    apiVersion: v1\n"},
+		"missing template data": {"operandConfig": "remove:\n- {{ .NotAField }}\n"},
+		"unknown overlay field": {"operandConfig": "replace: []\n"},
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := templateOverlayFromConfigMap(newTemplateOverlayConfigMap("site", data), apiv3.CSData{}); err == nil {
+				t.Fatalf("expected an error for %v", data)
+			}
+		})
+	}
+}
+
+func TestApplyOperandConfigOverlays(t *testing.T) {
+	bs := buildOverlayBootstrap(t, newTemplateOverlayConfigMap("site", map[string]string{
+		"operandConfig": `
+add:
+- name: site-operator
+  spec:
+    siteOperand:
+      replicas: 2
+patch:
+- name: ibm-usage-metering-operator
+  spec:
+    ibmUsageMetering:
+      enabled: false
+remove:
+- ibm-licensing-operator
+`,
+	}))
+	ctx := context.Background()
+
+	if err := bs.LoadTemplateOverlays(ctx); err != nil {
+		t.Fatalf("LoadTemplateOverlays returned error: %v", err)
+	}
+	rendered, err := constant.RenderTemplate(constant.CSV4OpCon, bs.CSData)
+	if err != nil {
+		t.Fatalf("failed to render OperandConfig: %v", err)
+	}
+	opcon, err := bs.ApplyOperandConfigOverlays(string(rendered))
+	if err != nil {
+		t.Fatalf("ApplyOperandConfigOverlays returned error: %v", err)
+	}
+	if len(bs.templateOverlayErrs) != 0 {
+		t.Fatalf("unexpected overlay errors: %v", bs.templateOverlayErrs)
+	}
+
+	config := &odlm.OperandConfig{}
+	if err := utilyaml.Unmarshal([]byte(opcon), config); err != nil {
+		t.Fatalf("failed to unmarshal OperandConfig: %v", err)
+	}
+	services := map[string]odlm.ConfigService{}
+	for _, service := range config.Spec.Services {
+		services[service.Name] = service
+	}
+	if _, ok := services["ibm-licensing-operator"]; ok {
+		t.Errorf("expected ibm-licensing-operator to be removed")
+	}
+	if added, ok := services["site-operator"]; !ok || added.Spec["siteOperand"].Raw == nil {
+		t.Errorf("expected site-operator to be added, got %+v", added)
+	}
+	if patched := services["ibm-usage-metering-operator"]; string(patched.Spec["ibmUsageMetering"].Raw) != `{"enabled":false}` {
+		t.Errorf("expected ibm-usage-metering-operator to be patched, got %s", patched.Spec["ibmUsageMetering"].Raw)
+	}
+}
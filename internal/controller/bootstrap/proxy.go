@@ -0,0 +1,120 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+)
+
+// ResolveProxy returns the proxy of the CommonService CR. When no proxy URL
+// is set, it is detected from the cluster Proxy on OpenShift. It returns nil
+// when there is no proxy.
+func (b *Bootstrap) ResolveProxy(ctx context.Context, proxy *apiv3.Proxy) *apiv3.Proxy {
+	if proxy != nil && (proxy.HTTPProxy != "" || proxy.HTTPSProxy != "") {
+		return proxy
+	}
+	if !b.IsOpenShiftCluster() {
+		return nil
+	}
+
+	allowed, err := b.CanI(ctx, "config.openshift.io", "proxies", "get", "cluster")
+	if err != nil {
+		klog.Warningf("SSAR check for cluster Proxy failed: %v, skipping proxy detection", err)
+		return nil
+	}
+	if !allowed {
+		klog.V(2).Info("No permission to get the cluster Proxy, skipping proxy detection")
+		return nil
+	}
+
+	clusterProxy := &unstructured.Unstructured{}
+	clusterProxy.SetAPIVersion("config.openshift.io/v1")
+	clusterProxy.SetKind("Proxy")
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: "cluster"}, clusterProxy); err != nil {
+		klog.Warningf("Failed to get the cluster Proxy: %v, skipping proxy detection", err)
+		return nil
+	}
+
+	// the status holds the effective settings of the cluster
+	detected := &apiv3.Proxy{}
+	detected.HTTPProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "httpProxy")
+	detected.HTTPSProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "httpsProxy")
+	detected.NoProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "noProxy")
+	if detected.HTTPProxy == "" && detected.HTTPSProxy == "" {
+		return nil
+	}
+	if proxy != nil && proxy.NoProxy != "" {
+		detected.NoProxy = proxy.NoProxy + "," + detected.NoProxy
+	}
+	klog.Infof("Detected the cluster proxy, HTTP proxy: %q, HTTPS proxy: %q", detected.HTTPProxy, detected.HTTPSProxy)
+	return detected
+}
+
+// WithProxy sets the proxy environment variables in the Subscription config
+// of each operator, the other environment variables are kept. OLM sets them
+// on the operator Deployments, the operand Deployments are not changed.
+func WithProxy(proxy *apiv3.Proxy, namespaces ...string) OperandRegistryOption {
+	env := util.ProxyEnv(proxy, namespaces...)
+	return func(reg *odlm.OperandRegistry) error {
+		if reg == nil {
+			return fmt.Errorf("operand registry option received nil registry")
+		}
+		if len(env) == 0 {
+			return nil
+		}
+
+		for i := range reg.Spec.Operators {
+			operator := &reg.Spec.Operators[i]
+			if operator.SubscriptionConfig == nil {
+				operator.SubscriptionConfig = &olmv1alpha1.SubscriptionConfig{}
+			}
+			operator.SubscriptionConfig.Env = mergeEnv(operator.SubscriptionConfig.Env, env)
+		}
+
+		return nil
+	}
+}
+
+// mergeEnv replaces the variables of env that are set in overrides, and
+// appends the others
+func mergeEnv(env, overrides []corev1.EnvVar) []corev1.EnvVar {
+	merged := append([]corev1.EnvVar{}, env...)
+	for _, override := range overrides {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == override.Name {
+				merged[i] = override
+				replaced = true
+			}
+		}
+		if !replaced {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,246 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// replicationLagMetric is the metric of the time since the last transaction
+// replayed by a PostgreSQL instance
+const replicationLagMetric = "cnpg_pg_replication_lag"
+
+// ReconcilePostgreSQLReplica records the role of common-service-db configured
+// by CSPostgreSQLReplica. A promotion or a demotion is completed when the
+// Cluster reports its primary in the new role, then the consumers of the
+// database are scaled up for a primary, or down for a replica.
+func (b *Bootstrap) ReconcilePostgreSQLReplica(ctx context.Context, instance *apiv3.CommonService) error {
+	config := instance.Spec.CSPostgreSQLReplica
+	if config == nil {
+		instance.Status.PostgreSQLReplica = nil
+		return nil
+	}
+	cluster, err := b.GetPostgresCluster(ctx)
+	if err != nil || cluster == nil {
+		return err
+	}
+
+	status := instance.Status.PostgreSQLReplica
+	if status == nil {
+		status = &apiv3.PostgreSQLReplicaStatus{}
+		instance.Status.PostgreSQLReplica = status
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	desiredRole := desiredReplicaRole(config)
+	action := apiv3.ReplicaActionDemote
+	if desiredRole == apiv3.ReplicaRolePrimary {
+		action = apiv3.ReplicaActionPromote
+	}
+
+	// start a transition when the desired role changes, a transition in
+	// progress is replaced when the action is reverted
+	last := lastReplicaTransition(status)
+	inProgress := last != nil && last.CompletionTime == ""
+	if inProgress && last.Action != action {
+		klog.Infof("%s of %s is replaced by %s", last.Action, constant.CSPGCluster, action)
+		last.Action, last.StartTime = action, now
+	} else if !inProgress && status.Role != "" && status.Role != desiredRole {
+		klog.Infof("Starting %s of %s", action, constant.CSPGCluster)
+		status.Transitions = append(status.Transitions, apiv3.PostgreSQLReplicaTransition{Action: action, StartTime: now})
+		if len(status.Transitions) > constant.MaxReplicaTransitions {
+			status.Transitions = status.Transitions[len(status.Transitions)-constant.MaxReplicaTransitions:]
+		}
+		last, inProgress = lastReplicaTransition(status), true
+	}
+
+	observedRole, ready := observedReplicaRole(cluster)
+	status.CurrentPrimary, _, _ = unstructured.NestedString(cluster.Object, "status", "currentPrimary")
+	if inProgress {
+		if ready && observedRole == desiredRole {
+			klog.Infof("%s of %s is completed, the primary is %s", action, constant.CSPGCluster, status.CurrentPrimary)
+			last.CompletionTime = now
+			last.Primary = status.CurrentPrimary
+			status.Role = desiredRole
+			if err := b.updateReplicaDependents(ctx, desiredRole); err != nil {
+				return err
+			}
+		}
+	} else if status.Role == "" && ready {
+		status.Role = observedRole
+	}
+
+	status.ReplicationLag, status.LastWALReceived = "", ""
+	if status.Role == apiv3.ReplicaRoleReplica && status.CurrentPrimary != "" {
+		lag, lastWAL, err := b.replicationStatus(ctx, status.CurrentPrimary)
+		if err != nil {
+			klog.Warningf("Failed to get the replication status of %s: %v", status.CurrentPrimary, err)
+		} else {
+			status.ReplicationLag, status.LastWALReceived = lag, lastWAL
+		}
+	}
+	return nil
+}
+
+// ReplicaTransitionInProgress returns true when a promotion or a demotion of
+// common-service-db is not completed
+func ReplicaTransitionInProgress(instance *apiv3.CommonService) bool {
+	if instance.Spec.CSPostgreSQLReplica == nil || instance.Status.PostgreSQLReplica == nil {
+		return false
+	}
+	last := lastReplicaTransition(instance.Status.PostgreSQLReplica)
+	return last != nil && last.CompletionTime == ""
+}
+
+// desiredReplicaRole returns the role of common-service-db set by the action,
+// or by replica.enabled without an action
+func desiredReplicaRole(config *apiv3.CSPostgreSQLReplicaConfig) string {
+	switch config.Action {
+	case apiv3.ReplicaActionPromote:
+		return apiv3.ReplicaRolePrimary
+	case apiv3.ReplicaActionDemote:
+		return apiv3.ReplicaRoleReplica
+	}
+	if config.Replica.Enabled != nil && !*config.Replica.Enabled {
+		return apiv3.ReplicaRolePrimary
+	}
+	return apiv3.ReplicaRoleReplica
+}
+
+// lastReplicaTransition returns the latest promotion or demotion
+func lastReplicaTransition(status *apiv3.PostgreSQLReplicaStatus) *apiv3.PostgreSQLReplicaTransition {
+	if len(status.Transitions) == 0 {
+		return nil
+	}
+	return &status.Transitions[len(status.Transitions)-1]
+}
+
+// observedReplicaRole returns the role of the Cluster, and whether the Cluster
+// is healthy with its primary in that role
+func observedReplicaRole(cluster *unstructured.Unstructured) (string, bool) {
+	role := apiv3.ReplicaRolePrimary
+	if replica, found, _ := unstructured.NestedMap(cluster.Object, "spec", "replica"); found {
+		if enabled, ok := replica["enabled"].(bool); !ok || enabled {
+			role = apiv3.ReplicaRoleReplica
+		}
+	}
+
+	phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase")
+	currentPrimary, _, _ := unstructured.NestedString(cluster.Object, "status", "currentPrimary")
+	targetPrimary, _, _ := unstructured.NestedString(cluster.Object, "status", "targetPrimary")
+	ready := phase == postgresClusterHealthy && currentPrimary != "" && currentPrimary == targetPrimary
+
+	// the Cluster hasn't reported the last change of its spec yet
+	conditions, _, _ := unstructured.NestedSlice(cluster.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if observed, found, _ := unstructured.NestedInt64(condition, "observedGeneration"); found && observed < cluster.GetGeneration() {
+			ready = false
+		}
+	}
+	return role, ready
+}
+
+// updateReplicaDependents scales the consumers of common-service-db up when it
+// is promoted to a primary, and down when it is demoted to a read-only replica
+func (b *Bootstrap) updateReplicaDependents(ctx context.Context, role string) error {
+	if role == apiv3.ReplicaRolePrimary {
+		return b.scaleUpDBConsumers(ctx)
+	}
+	_, err := b.scaleDownDBConsumers(ctx)
+	return err
+}
+
+// replicationStatus returns the replication lag and the last WAL received of
+// the designated primary of a replica cluster from its instance manager and
+// its metrics through the API server proxy
+func (b *Bootstrap) replicationStatus(ctx context.Context, pod string) (string, string, error) {
+	if b.Config == nil {
+		return "", "", nil
+	}
+	clientset, err := kubernetes.NewForConfig(b.Config)
+	if err != nil {
+		return "", "", err
+	}
+	pods := clientset.CoreV1().Pods(b.CSData.ServicesNs)
+
+	instanceStatus, err := pods.ProxyGet("https", pod, "8000", "/pg/status", nil).DoRaw(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the instance status: %v", err)
+	}
+	lastWAL, err := parseLastWALReceived(instanceStatus)
+	if err != nil {
+		return "", "", err
+	}
+
+	metrics, err := pods.ProxyGet("http", pod, "9187", "/metrics", nil).DoRaw(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the metrics: %v", err)
+	}
+	lag, err := parseReplicationLag(metrics)
+	if err != nil {
+		return "", "", err
+	}
+	return lag, lastWAL, nil
+}
+
+// parseLastWALReceived returns the location of the last WAL received in the
+// status of a PostgreSQL instance
+func parseLastWALReceived(instanceStatus []byte) (string, error) {
+	status := struct {
+		ReceivedLsn string `json:"receivedLsn"`
+	}{}
+	if err := json.Unmarshal(instanceStatus, &status); err != nil {
+		return "", fmt.Errorf("invalid instance status: %v", err)
+	}
+	return status.ReceivedLsn, nil
+}
+
+// parseReplicationLag returns the replication lag in the metrics of a
+// PostgreSQL instance, it is empty when the metric is not found
+func parseReplicationLag(metrics []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, replicationLagMetric+" ") && !strings.HasPrefix(line, replicationLagMetric+"{") {
+			continue
+		}
+		fields := strings.Fields(line)
+		seconds, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid metric %q: %v", line, err)
+		}
+		return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond).String(), nil
+	}
+	return "", scanner.Err()
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func setReplicaCluster(t *testing.T, bs *Bootstrap, replica bool) {
+	t.Helper()
+	cluster := &unstructured.Unstructured{}
+	cluster.SetAPIVersion("pg.ibm.com/v1")
+	cluster.SetKind("Cluster")
+	err := bs.Client.Get(context.Background(), types.NamespacedName{Name: constant.CSPGCluster, Namespace: bs.CSData.ServicesNs}, cluster)
+	create := err != nil
+	if create {
+		cluster.SetName(constant.CSPGCluster)
+		cluster.SetNamespace(bs.CSData.ServicesNs)
+	}
+	cluster.Object["spec"] = map[string]interface{}{"replica": map[string]interface{}{"enabled": replica, "source": "primary-cluster"}}
+	cluster.Object["status"] = map[string]interface{}{
+		"phase":          postgresClusterHealthy,
+		"currentPrimary": "common-service-db-1",
+		"targetPrimary":  "common-service-db-1",
+	}
+	if create {
+		err = bs.Client.Create(context.Background(), cluster)
+	} else {
+		err = bs.Client.Update(context.Background(), cluster)
+	}
+	if err != nil {
+		t.Fatalf("failed to set the cluster: %v", err)
+	}
+}
+
+func reconcileReplica(t *testing.T, bs *Bootstrap, instance *apiv3.CommonService, role string, transitions int) {
+	t.Helper()
+	if err := bs.ReconcilePostgreSQLReplica(context.Background(), instance); err != nil {
+		t.Fatalf("ReconcilePostgreSQLReplica returned error: %v", err)
+	}
+	status := instance.Status.PostgreSQLReplica
+	if status == nil || status.Role != role || len(status.Transitions) != transitions {
+		t.Fatalf("expected role %s with %d transitions, got %+v", role, transitions, status)
+	}
+}
+
+func TestReconcilePostgreSQLReplica(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps/v1 to the scheme: %v", err)
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).Build()
+	bs.Reader = bs.Client
+	ctx := context.Background()
+
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform-identity-provider", Namespace: bs.CSData.ServicesNs},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+	if err := bs.Client.Create(ctx, deploy); err != nil {
+		t.Fatalf("failed to create the deployment: %v", err)
+	}
+	key := types.NamespacedName{Name: deploy.Name, Namespace: deploy.Namespace}
+
+	setReplicaCluster(t, bs, true)
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{CSPostgreSQLReplica: &apiv3.CSPostgreSQLReplicaConfig{}}}
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRoleReplica, 0)
+	if instance.Status.PostgreSQLReplica.CurrentPrimary != "common-service-db-1" {
+		t.Fatalf("expected the current primary common-service-db-1, got %+v", instance.Status.PostgreSQLReplica)
+	}
+
+	// the promotion waits for the cluster to leave the replica mode
+	instance.Spec.CSPostgreSQLReplica.Action = apiv3.ReplicaActionPromote
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRoleReplica, 1)
+	if !ReplicaTransitionInProgress(instance) {
+		t.Fatalf("expected the promotion to be in progress, got %+v", instance.Status.PostgreSQLReplica)
+	}
+
+	setReplicaCluster(t, bs, false)
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRolePrimary, 1)
+	transition := instance.Status.PostgreSQLReplica.Transitions[0]
+	if ReplicaTransitionInProgress(instance) || transition.Action != apiv3.ReplicaActionPromote || transition.Primary != "common-service-db-1" {
+		t.Fatalf("expected a completed promotion, got %+v", transition)
+	}
+
+	// the consumers are scaled down while the cluster is a read-only replica
+	instance.Spec.CSPostgreSQLReplica.Action = apiv3.ReplicaActionDemote
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRolePrimary, 2)
+	setReplicaCluster(t, bs, true)
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRoleReplica, 2)
+	if err := bs.Client.Get(ctx, key, deploy); err != nil {
+		t.Fatalf("failed to get the deployment: %v", err)
+	}
+	if *deploy.Spec.Replicas != 0 || deploy.Annotations[constant.DBConsumerReplicasAnno] != "3" {
+		t.Fatalf("expected the deployment to be scaled down from 3 replicas, got %d replicas and annotations %v", *deploy.Spec.Replicas, deploy.Annotations)
+	}
+
+	instance.Spec.CSPostgreSQLReplica.Action = apiv3.ReplicaActionPromote
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRoleReplica, 3)
+	setReplicaCluster(t, bs, false)
+	reconcileReplica(t, bs, instance, apiv3.ReplicaRolePrimary, 3)
+	if err := bs.Client.Get(ctx, key, deploy); err != nil {
+		t.Fatalf("failed to get the deployment: %v", err)
+	}
+	if *deploy.Spec.Replicas != 3 {
+		t.Fatalf("expected the deployment to be scaled up to 3 replicas, got %d", *deploy.Spec.Replicas)
+	}
+
+	instance.Spec.CSPostgreSQLReplica = nil
+	if err := bs.ReconcilePostgreSQLReplica(ctx, instance); err != nil || instance.Status.PostgreSQLReplica != nil {
+		t.Fatalf("expected no replica status without CSPostgreSQLReplica, got %+v, %v", instance.Status.PostgreSQLReplica, err)
+	}
+}
+
+func TestParseReplicationStatus(t *testing.T) {
+	lastWAL, err := parseLastWALReceived([]byte(`{"currentLsn":"0/5000060","receivedLsn":"0/5000060","replayLsn":"0/5000028","isPrimary":false}`))
+	if err != nil || lastWAL != "0/5000060" {
+		t.Fatalf("expected the last WAL received 0/5000060, got %q, %v", lastWAL, err)
+	}
+
+	metrics := []byte(`# HELP cnpg_pg_replication_lag Replication lag behind primary in seconds
+# TYPE cnpg_pg_replication_lag gauge
+cnpg_pg_replication_lag 2.5
+cnpg_pg_replication_in_recovery 1
+`)
+	lag, err := parseReplicationLag(metrics)
+	if err != nil || lag != "2.5s" {
+		t.Fatalf("expected the replication lag 2.5s, got %q, %v", lag, err)
+	}
+
+	if lag, err := parseReplicationLag([]byte("cnpg_pg_replication_in_recovery 1\n")); err != nil || lag != "" {
+		t.Fatalf("expected no replication lag without the metric, got %q, %v", lag, err)
+	}
+}
@@ -0,0 +1,218 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// postgresClusterHealthy is the phase of a healthy common-service-db
+const postgresClusterHealthy = "Cluster in healthy state"
+
+// ReconcileRestore moves the restore of common-service-db to its next phase.
+// The consumers of the database are scaled down, the Cluster is recreated from
+// the backup, and the consumers are scaled up again when it is healthy.
+func (b *Bootstrap) ReconcileRestore(ctx context.Context, instance *apiv3.CommonService) error {
+	restore, status := instance.Spec.Restore, instance.Status.Restore
+	if restore == nil {
+		if status != nil && status.Phase != apiv3.RestoreCompleted {
+			klog.Infof("Restore %s of %s is cancelled", status.Name, constant.CSPGCluster)
+			if err := b.scaleUpDBConsumers(ctx); err != nil {
+				return err
+			}
+		}
+		instance.Status.Restore = nil
+		return nil
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if status == nil || status.Name != restore.Name {
+		status = &apiv3.RestoreStatus{
+			Name:      restore.Name,
+			Phase:     apiv3.RestorePending,
+			StartTime: now,
+		}
+		instance.Status.Restore = status
+	}
+
+	switch status.Phase {
+	case apiv3.RestorePending, apiv3.RestoreBlocked:
+		if reason, err := b.restoreBlockedReason(ctx, restore); err != nil {
+			return err
+		} else if reason != "" {
+			status.Phase = apiv3.RestoreBlocked
+			status.Message = reason
+			return nil
+		}
+		klog.Infof("Starting restore %s of %s", restore.Name, constant.CSPGCluster)
+		status.Phase = apiv3.RestoreScalingDown
+		fallthrough
+
+	case apiv3.RestoreScalingDown:
+		scaledDown, err := b.scaleDownDBConsumers(ctx)
+		if err != nil {
+			return err
+		}
+		if !scaledDown {
+			status.Message = fmt.Sprintf("waiting for the consumers of %s to scale down", constant.CSPGCluster)
+			return nil
+		}
+		// the recovery bootstrap is added to the OperandConfig in this
+		// reconcile, the Cluster is deleted in the next one
+		status.Phase = apiv3.RestoreRecovering
+		status.RecoveryStartTime = now
+		status.Message = fmt.Sprintf("recreating %s from the backup", constant.CSPGCluster)
+
+	case apiv3.RestoreRecovering:
+		return b.recoverPostgresCluster(ctx, status)
+	}
+	return nil
+}
+
+// restoreBlockedReason returns why the restore can't start, it is empty when
+// the restore can start
+func (b *Bootstrap) restoreBlockedReason(ctx context.Context, restore *apiv3.Restore) (string, error) {
+	cluster, err := b.GetPostgresCluster(ctx)
+	if err != nil {
+		return "", err
+	}
+	if cluster != nil && isHealthyPrimary(cluster) && !restore.OverwriteHealthyCluster {
+		return fmt.Sprintf("%s is a healthy primary, set overwriteHealthyCluster to replace its data with the backup", constant.CSPGCluster), nil
+	}
+
+	if restore.Backup != "" {
+		backup, err := b.getPostgresResource(ctx, "Backup", restore.Backup)
+		if err != nil {
+			return "", err
+		}
+		if backup == nil {
+			return fmt.Sprintf("Backup %s is not found in namespace %s", restore.Backup, b.CSData.ServicesNs), nil
+		}
+		if phase, _, _ := unstructured.NestedString(backup.Object, "status", "phase"); phase != "completed" {
+			return fmt.Sprintf("Backup %s is not completed, its phase is %q", restore.Backup, phase), nil
+		}
+	}
+	return "", nil
+}
+
+// recoverPostgresCluster deletes the existing common-service-db once the
+// OperandConfig has the recovery bootstrap, and completes the restore when
+// the recreated Cluster is healthy
+func (b *Bootstrap) recoverPostgresCluster(ctx context.Context, status *apiv3.RestoreStatus) error {
+	recoveryStart, err := time.Parse(time.RFC3339, status.RecoveryStartTime)
+	if err != nil {
+		return fmt.Errorf("invalid recovery start time %q of restore %s: %v", status.RecoveryStartTime, status.Name, err)
+	}
+
+	cluster, err := b.GetPostgresCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		status.Message = fmt.Sprintf("waiting for %s to be recreated from the backup", constant.CSPGCluster)
+		return nil
+	}
+
+	if cluster.GetCreationTimestamp().Time.Before(recoveryStart) {
+		if cluster.GetDeletionTimestamp() != nil {
+			status.Message = fmt.Sprintf("waiting for the existing %s to be deleted", constant.CSPGCluster)
+			return nil
+		}
+		// ODLM recreates the Cluster from the OperandConfig, it must not
+		// be deleted before the recovery bootstrap is there
+		rendered, err := b.recoveryBootstrapRendered(ctx)
+		if err != nil {
+			return err
+		}
+		if !rendered {
+			status.Message = "waiting for the recovery bootstrap in the OperandConfig"
+			return nil
+		}
+		klog.Infof("Deleting %s/%s to recreate it from the backup of restore %s", cluster.GetNamespace(), cluster.GetName(), status.Name)
+		if err := b.Client.Delete(ctx, cluster); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		status.Message = fmt.Sprintf("deleting the existing %s", constant.CSPGCluster)
+		return nil
+	}
+
+	if phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase"); phase != postgresClusterHealthy {
+		status.Message = fmt.Sprintf("waiting for %s to recover from the backup: %s", constant.CSPGCluster, phase)
+		return nil
+	}
+
+	if err := b.scaleUpDBConsumers(ctx); err != nil {
+		return err
+	}
+	klog.Infof("Restore %s of %s is completed", status.Name, constant.CSPGCluster)
+	status.Phase = apiv3.RestoreCompleted
+	status.CompletionTime = time.Now().UTC().Format(time.RFC3339)
+	status.Message = fmt.Sprintf("%s is recovered from the backup", constant.CSPGCluster)
+	return nil
+}
+
+// recoveryBootstrapRendered returns true when the common-service-db Cluster in
+// the OperandConfig is bootstrapped from the backup
+func (b *Bootstrap) recoveryBootstrapRendered(ctx context.Context) (bool, error) {
+	opconfig, err := b.GetOperandConfig(ctx, constant.MasterCR, b.CSData.ServicesNs)
+	if err != nil {
+		return false, err
+	}
+	for _, service := range opconfig.Spec.Services {
+		for _, resource := range service.Resources {
+			if resource.Kind != "Cluster" || resource.Name != constant.CSPGCluster || resource.Data == nil {
+				continue
+			}
+			data := map[string]interface{}{}
+			if err := json.Unmarshal(resource.Data.Raw, &data); err != nil {
+				return false, err
+			}
+			if _, found, _ := unstructured.NestedMap(data, "spec", "bootstrap", "recovery"); found {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// isHealthyPrimary returns true when the Cluster is healthy and is not a
+// replica of another cluster
+func isHealthyPrimary(cluster *unstructured.Unstructured) bool {
+	phase, _, _ := unstructured.NestedString(cluster.Object, "status", "phase")
+	replica, _, _ := unstructured.NestedBool(cluster.Object, "spec", "replica", "enabled")
+	return phase == postgresClusterHealthy && !replica
+}
+
+// checkRestoreWarning sets a warning when the restore is blocked
+func (b *Bootstrap) checkRestoreWarning(instance *apiv3.CommonService) {
+	status := instance.Status.Restore
+	if instance.Spec.Restore == nil || status == nil || status.Phase != apiv3.RestoreBlocked {
+		return
+	}
+	instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageRestoreBlocked, status.Name, status.Message))
+}
@@ -0,0 +1,159 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func createRestoreCluster(t *testing.T, bs *Bootstrap, created time.Time, phase string) {
+	t.Helper()
+	cluster := &unstructured.Unstructured{}
+	cluster.SetAPIVersion("pg.ibm.com/v1")
+	cluster.SetKind("Cluster")
+	cluster.SetName(constant.CSPGCluster)
+	cluster.SetNamespace(bs.CSData.ServicesNs)
+	cluster.SetCreationTimestamp(metav1.NewTime(created))
+	if err := unstructured.SetNestedField(cluster.Object, phase, "status", "phase"); err != nil {
+		t.Fatalf("failed to set the cluster phase: %v", err)
+	}
+	if err := bs.Client.Create(context.Background(), cluster); err != nil {
+		t.Fatalf("failed to create the cluster: %v", err)
+	}
+}
+
+func reconcileRestore(t *testing.T, bs *Bootstrap, instance *apiv3.CommonService, phase string) {
+	t.Helper()
+	if err := bs.ReconcileRestore(context.Background(), instance); err != nil {
+		t.Fatalf("ReconcileRestore returned error: %v", err)
+	}
+	if instance.Status.Restore == nil || instance.Status.Restore.Phase != phase {
+		t.Fatalf("expected restore phase %s, got %+v", phase, instance.Status.Restore)
+	}
+}
+
+func TestReconcileRestore(t *testing.T) {
+	bs := buildTestBootstrap(t)
+	scheme := bs.Client.Scheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apps/v1 to the scheme: %v", err)
+	}
+	bs.Client = fake.NewClientBuilder().WithScheme(scheme).Build()
+	bs.Reader = bs.Client
+	ctx := context.Background()
+
+	createRestoreCluster(t, bs, time.Now().Add(-time.Hour), postgresClusterHealthy)
+	replicas := int32(2)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform-auth-service", Namespace: bs.CSData.ServicesNs},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	if err := bs.Client.Create(ctx, deploy); err != nil {
+		t.Fatalf("failed to create the deployment: %v", err)
+	}
+
+	instance := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{Restore: &apiv3.Restore{
+		Name:        "r1",
+		ObjectStore: &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs", CredentialsSecret: "backup-creds"},
+		TargetTime:  "2026-10-18T09:30:00Z",
+	}}}
+
+	// a healthy primary is not replaced by accident
+	reconcileRestore(t, bs, instance, apiv3.RestoreBlocked)
+	bs.checkRestoreWarning(instance)
+	if len(instance.Status.Conditions) == 0 || instance.Status.Conditions[0].Type != apiv3.ConditionTypeWarning {
+		t.Fatalf("expected a warning condition for the blocked restore, got %v", instance.Status.Conditions)
+	}
+
+	instance.Spec.Restore.OverwriteHealthyCluster = true
+	reconcileRestore(t, bs, instance, apiv3.RestoreScalingDown)
+	key := types.NamespacedName{Name: "platform-auth-service", Namespace: bs.CSData.ServicesNs}
+	if err := bs.Client.Get(ctx, key, deploy); err != nil {
+		t.Fatalf("failed to get the deployment: %v", err)
+	}
+	if *deploy.Spec.Replicas != 0 || deploy.Annotations[constant.DBConsumerReplicasAnno] != "2" {
+		t.Fatalf("expected the deployment to be scaled down from 2 replicas, got %d replicas and annotations %v", *deploy.Spec.Replicas, deploy.Annotations)
+	}
+
+	deploy.Status.Replicas = 0
+	if err := bs.Client.Status().Update(ctx, deploy); err != nil {
+		t.Fatalf("failed to update the deployment: %v", err)
+	}
+	reconcileRestore(t, bs, instance, apiv3.RestoreRecovering)
+
+	// the cluster is kept until the OperandConfig has the recovery bootstrap
+	reconcileRestore(t, bs, instance, apiv3.RestoreRecovering)
+	if cluster, err := bs.GetPostgresCluster(ctx); err != nil || cluster == nil {
+		t.Fatalf("expected the cluster to be kept, got %v, %v", cluster, err)
+	}
+
+	opconfig := &odlm.OperandConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: bs.CSData.ServicesNs},
+		Spec: odlm.OperandConfigSpec{Services: []odlm.ConfigService{{
+			Name: "common-service-cnpg",
+			Resources: []odlm.ConfigResource{{
+				APIVersion: "pg.ibm.com/v1",
+				Kind:       "Cluster",
+				Name:       constant.CSPGCluster,
+				Data:       &runtime.RawExtension{Raw: []byte(`{"spec":{"bootstrap":{"recovery":{"source":"common-service-db-restore-source"}}}}`)},
+			}},
+		}}},
+	}
+	if err := bs.Client.Create(ctx, opconfig); err != nil {
+		t.Fatalf("failed to create the OperandConfig: %v", err)
+	}
+	reconcileRestore(t, bs, instance, apiv3.RestoreRecovering)
+	if cluster, err := bs.GetPostgresCluster(ctx); err != nil || cluster != nil {
+		t.Fatalf("expected the cluster to be deleted, got %v, %v", cluster, err)
+	}
+
+	createRestoreCluster(t, bs, time.Now().Add(time.Minute), "Setting up primary")
+	reconcileRestore(t, bs, instance, apiv3.RestoreRecovering)
+
+	if err := bs.Client.Delete(ctx, &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "pg.ibm.com/v1",
+		"kind":       "Cluster",
+		"metadata":   map[string]interface{}{"name": constant.CSPGCluster, "namespace": bs.CSData.ServicesNs},
+	}}); err != nil {
+		t.Fatalf("failed to delete the cluster: %v", err)
+	}
+	createRestoreCluster(t, bs, time.Now().Add(time.Minute), postgresClusterHealthy)
+	reconcileRestore(t, bs, instance, apiv3.RestoreCompleted)
+	if err := bs.Client.Get(ctx, key, deploy); err != nil {
+		t.Fatalf("failed to get the deployment: %v", err)
+	}
+	if *deploy.Spec.Replicas != 2 || deploy.Annotations[constant.DBConsumerReplicasAnno] != "" {
+		t.Fatalf("expected the deployment to be scaled up to 2 replicas, got %d replicas and annotations %v", *deploy.Spec.Replicas, deploy.Annotations)
+	}
+
+	// a completed restore is not started again
+	reconcileRestore(t, bs, instance, apiv3.RestoreCompleted)
+}
@@ -0,0 +1,107 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+)
+
+// Keys of a size profile ConfigMap
+const (
+	sizeProfileNameKey     = "name"
+	sizeProfilePriorityKey = "priority"
+	sizeProfileInheritsKey = "inherits"
+	sizeProfileServicesKey = "services"
+)
+
+// LoadSizeProfiles registers the user-defined size profiles from the labelled
+// ConfigMaps in the operator namespace. Invalid profiles are skipped and
+// reported as warnings on the master CommonService CR.
+func (b *Bootstrap) LoadSizeProfiles(ctx context.Context) error {
+	cmList := &corev1.ConfigMapList{}
+	if err := b.Client.List(ctx, cmList, client.InNamespace(b.CSData.OperatorNs), client.MatchingLabels{
+		constant.CsManagedLabel:   "true",
+		constant.SizeProfileLabel: "true",
+	}); err != nil {
+		return fmt.Errorf("failed to list size profile ConfigMaps: %v", err)
+	}
+
+	var profiles []size.Profile
+	var profileErrs []error
+	for i := range cmList.Items {
+		profile, err := SizeProfileFromConfigMap(&cmList.Items[i])
+		if err != nil {
+			profileErrs = append(profileErrs, err)
+			continue
+		}
+		profiles = append(profiles, profile)
+	}
+	profileErrs = append(profileErrs, size.SetCustomProfiles(profiles)...)
+
+	for _, err := range profileErrs {
+		klog.Warningf("Skipping size profile: %v", err)
+	}
+	klog.V(2).Infof("Loaded %d size profile ConfigMaps, %d skipped", len(cmList.Items), len(profileErrs))
+	b.sizeProfileErrs = profileErrs
+	return nil
+}
+
+// SizeProfileFromConfigMap converts a size profile ConfigMap into a profile.
+// The profile name defaults to the ConfigMap name.
+func SizeProfileFromConfigMap(cm *corev1.ConfigMap) (size.Profile, error) {
+	profile := size.Profile{
+		Name:     cm.Name,
+		Inherits: strings.TrimSpace(cm.Data[sizeProfileInheritsKey]),
+		Services: cm.Data[sizeProfileServicesKey],
+	}
+	if name := strings.TrimSpace(cm.Data[sizeProfileNameKey]); name != "" {
+		profile.Name = name
+	}
+
+	priority, ok := cm.Data[sizeProfilePriorityKey]
+	if !ok {
+		return profile, fmt.Errorf("ConfigMap %s/%s has no %s", cm.Namespace, cm.Name, sizeProfilePriorityKey)
+	}
+	rank, err := strconv.Atoi(strings.TrimSpace(priority))
+	if err != nil {
+		return profile, fmt.Errorf("ConfigMap %s/%s has an invalid %s %q: %v", cm.Namespace, cm.Name, sizeProfilePriorityKey, priority, err)
+	}
+	profile.Priority = rank
+
+	if err := size.ValidateProfile(profile); err != nil {
+		return profile, fmt.Errorf("ConfigMap %s/%s: %v", cm.Namespace, cm.Name, err)
+	}
+	return profile, nil
+}
+
+// checkSizeProfileWarning sets a warning for each size profile skipped by LoadSizeProfiles
+func (b *Bootstrap) checkSizeProfileWarning(instance *apiv3.CommonService) {
+	for _, err := range b.sizeProfileErrs {
+		instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning, fmt.Sprintf(apiv3.ConditionMessageInvalidSizeProfile, err))
+	}
+}
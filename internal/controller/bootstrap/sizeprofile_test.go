@@ -0,0 +1,107 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+)
+
+func newSizeProfileConfigMap(name, namespace string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				constant.CsManagedLabel:   "true",
+				constant.SizeProfileLabel: "true",
+			},
+		},
+		Data: data,
+	}
+}
+
+func TestSizeProfileFromConfigMap(t *testing.T) {
+	profile, err := SizeProfileFromConfigMap(newSizeProfileConfigMap("xlarge", "cs-operator", map[string]string{
+		"priority": " 500 ",
+		"inherits": "large",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, size.Profile{Name: "xlarge", Priority: 500, Inherits: "large"}, profile)
+
+	profile, err = SizeProfileFromConfigMap(newSizeProfileConfigMap("edge-profile", "cs-operator", map[string]string{
+		"name":     "edge",
+		"priority": "50",
+		"services": "- name: ibm-im-operator\n",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "edge", profile.Name)
+
+	_, err = SizeProfileFromConfigMap(newSizeProfileConfigMap("edge", "cs-operator", map[string]string{
+		"inherits": "large",
+	}))
+	assert.Error(t, err, "priority is required")
+
+	_, err = SizeProfileFromConfigMap(newSizeProfileConfigMap("edge", "cs-operator", map[string]string{
+		"priority": "high",
+		"inherits": "large",
+	}))
+	assert.Error(t, err, "priority must be a number")
+}
+
+func TestLoadSizeProfiles(t *testing.T) {
+	defer size.SetCustomProfiles(nil)
+
+	b := buildTestBootstrap(t)
+	b.CSData.OperatorNs = "cs-operator"
+	ctx := context.Background()
+
+	for _, cm := range []*corev1.ConfigMap{
+		newSizeProfileConfigMap("xlarge", "cs-operator", map[string]string{"priority": "500", "inherits": "large"}),
+		newSizeProfileConfigMap("broken", "cs-operator", map[string]string{"priority": "500", "inherits": "huge"}),
+		newSizeProfileConfigMap("other-ns", "other", map[string]string{"priority": "600", "inherits": "large"}),
+	} {
+		require.NoError(t, b.Client.Create(ctx, cm))
+	}
+	unlabelled := newSizeProfileConfigMap("unlabelled", "cs-operator", map[string]string{"priority": "700", "inherits": "large"})
+	unlabelled.Labels = nil
+	require.NoError(t, b.Client.Create(ctx, unlabelled))
+
+	require.NoError(t, b.LoadSizeProfiles(ctx))
+
+	_, ok := size.Lookup("xlarge")
+	assert.True(t, ok)
+	for _, name := range []string{"broken", "other-ns", "unlabelled"} {
+		_, ok := size.Lookup(name)
+		assert.Falsef(t, ok, "profile %s must not be registered", name)
+	}
+
+	instance := &apiv3.CommonService{}
+	b.checkSizeProfileWarning(instance)
+	require.Len(t, instance.Status.Conditions, 1)
+	assert.Equal(t, apiv3.ConditionTypeWarning, instance.Status.Conditions[0].Type)
+	assert.Contains(t, instance.Status.Conditions[0].Message, "broken")
+}
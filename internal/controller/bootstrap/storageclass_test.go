@@ -0,0 +1,113 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestCheckServiceStorageClasses(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = storagev1.AddToScheme(testScheme)
+	_ = authzv1.AddToScheme(testScheme)
+	_ = apiv3.AddToScheme(testScheme)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if ssar, ok := obj.(*authzv1.SelfSubjectAccessReview); ok {
+					ssar.Status.Allowed = true
+					return nil
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).Build()
+	bs := &Bootstrap{Client: fakeClient, Reader: fakeClient}
+
+	bs.CheckServiceStorageClasses(context.Background(), []apiv3.ServiceStorage{
+		{Name: "ibm-im-mongodb-operator", StorageClass: "standard"},
+		{Name: "common-service-postgresql", StorageClass: "fast-block"},
+	})
+	if len(bs.storageIssues) != 1 || !strings.Contains(bs.storageIssues[0], "fast-block of service common-service-postgresql") {
+		t.Fatalf("expected the missing StorageClass of common-service-postgresql, got %v", bs.storageIssues)
+	}
+
+	instance := &apiv3.CommonService{}
+	bs.checkStorageClassWarning(instance)
+	found := false
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == apiv3.ConditionTypeWarning && strings.Contains(condition.Message, "fast-block") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a warning condition for the missing StorageClass, got %v", instance.Status.Conditions)
+	}
+
+	bs.CheckServiceStorageClasses(context.Background(), nil)
+	if len(bs.storageIssues) != 0 {
+		t.Fatalf("expected no issues without service storage, got %v", bs.storageIssues)
+	}
+}
+
+func TestCheckPriorityClass(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = schedulingv1.AddToScheme(testScheme)
+	_ = authzv1.AddToScheme(testScheme)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "cpfs-critical"}}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if ssar, ok := obj.(*authzv1.SelfSubjectAccessReview); ok {
+					ssar.Status.Allowed = true
+					return nil
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).Build()
+	bs := &Bootstrap{Client: fakeClient, Reader: fakeClient}
+
+	bs.CheckPriorityClass(context.Background(), "cpfs-critical")
+	if bs.priorityClassIssue != "" {
+		t.Fatalf("expected no issue for an existing PriorityClass, got %s", bs.priorityClassIssue)
+	}
+
+	bs.CheckPriorityClass(context.Background(), "cpfs-missing")
+	if bs.priorityClassIssue != "cpfs-missing" {
+		t.Fatalf("expected the missing PriorityClass cpfs-missing, got %q", bs.priorityClassIssue)
+	}
+	instance := &apiv3.CommonService{}
+	bs.checkPriorityClassWarning(instance)
+	if len(instance.Status.Conditions) != 1 || !strings.Contains(instance.Status.Conditions[0].Message, "cpfs-missing") {
+		t.Fatalf("expected a warning condition for the missing PriorityClass, got %v", instance.Status.Conditions)
+	}
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// ResolveTLSSecurityProfile returns the TLS security profile of the CommonService
+// CR. When it inherits from the cluster on OpenShift, the profile is read from
+// the cluster APIServer, and the CR profile is kept if it can't be read. It
+// returns nil when there is no profile.
+func (b *Bootstrap) ResolveTLSSecurityProfile(ctx context.Context, profile *apiv3.TLSSecurityProfile) *apiv3.TLSSecurityProfile {
+	b.tlsProfileType = ""
+	if profile == nil {
+		return nil
+	}
+	if profile.InheritFromCluster && b.IsOpenShiftCluster() {
+		if inherited := b.clusterTLSSecurityProfile(ctx); inherited != nil {
+			profile = inherited
+		}
+	}
+
+	b.tlsProfileType = profile.Type
+	if b.tlsProfileType == "" {
+		b.tlsProfileType = apiv3.TLSProfileIntermediate
+	}
+	return profile
+}
+
+// clusterTLSSecurityProfile reads the TLS security profile of the cluster
+// APIServer, the Intermediate profile is the default of OpenShift. It returns
+// nil when the profile can't be read or is invalid.
+func (b *Bootstrap) clusterTLSSecurityProfile(ctx context.Context) *apiv3.TLSSecurityProfile {
+	allowed, err := b.CanI(ctx, "config.openshift.io", "apiservers", "get", "cluster")
+	if err != nil {
+		klog.Warningf("SSAR check for cluster APIServer failed: %v, skipping TLS security profile inheritance", err)
+		return nil
+	}
+	if !allowed {
+		klog.V(2).Info("No permission to get the cluster APIServer, skipping TLS security profile inheritance")
+		return nil
+	}
+
+	apiServer := &unstructured.Unstructured{}
+	apiServer.SetAPIVersion("config.openshift.io/v1")
+	apiServer.SetKind("APIServer")
+	if err := b.Reader.Get(ctx, types.NamespacedName{Name: "cluster"}, apiServer); err != nil {
+		klog.Warningf("Failed to get the cluster APIServer: %v, skipping TLS security profile inheritance", err)
+		return nil
+	}
+
+	inherited := &apiv3.TLSSecurityProfile{}
+	inherited.Type, _, _ = unstructured.NestedString(apiServer.Object, "spec", "tlsSecurityProfile", "type")
+	if inherited.Type == "" {
+		inherited.Type = apiv3.TLSProfileIntermediate
+	}
+	if inherited.Type == apiv3.TLSProfileCustom {
+		inherited.Custom = &apiv3.CustomTLSProfile{}
+		inherited.Custom.Ciphers, _, _ = unstructured.NestedStringSlice(apiServer.Object, "spec", "tlsSecurityProfile", "custom", "ciphers")
+		inherited.Custom.MinTLSVersion, _, _ = unstructured.NestedString(apiServer.Object, "spec", "tlsSecurityProfile", "custom", "minTLSVersion")
+	}
+	if err := util.ValidateTLSSecurityProfile(inherited); err != nil {
+		klog.Warningf("Invalid TLS security profile of the cluster APIServer: %v, skipping TLS security profile inheritance", err)
+		return nil
+	}
+	klog.Infof("Inherited the TLS security profile %s of the cluster APIServer", inherited.Type)
+	return inherited
+}
+
+// checkTLSProfileWarning sets a warning for the services that can't honour
+// the TLS security profile
+func (b *Bootstrap) checkTLSProfileWarning(instance *apiv3.CommonService) {
+	if b.tlsProfileType == "" {
+		return
+	}
+	instance.SetWarningCondition(constant.MasterCR, apiv3.ConditionTypeWarning, corev1.ConditionTrue, apiv3.ConditionReasonWarning,
+		fmt.Sprintf(apiv3.ConditionMessageTLSProfile, b.tlsProfileType, strings.Join(constant.TLSProfileUnsupportedServices, ", ")))
+}
@@ -0,0 +1,179 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// digestPattern is the format of an image digest
+var digestPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// imageKeys are the keys holding an image in the operand specs
+var imageKeys = map[string]bool{
+	"image":     true,
+	"imageName": true,
+}
+
+// ImageRewriter rewrites images with the mirrors and the overrides of an
+// ImageRegistry, and checks them against the digest pins
+type ImageRewriter struct {
+	mirrors   []apiv3.RegistryMirror
+	overrides map[string]apiv3.ImageOverride
+}
+
+// NewImageRewriter validates the ImageRegistry and returns its rewriter. It
+// returns nil when there is no ImageRegistry.
+func NewImageRewriter(registry *apiv3.ImageRegistry) (*ImageRewriter, error) {
+	if registry == nil {
+		return nil, nil
+	}
+	w := &ImageRewriter{overrides: map[string]apiv3.ImageOverride{}}
+	for _, mirror := range registry.Mirrors {
+		if mirror.Source == "" || mirror.Mirror == "" {
+			return nil, fmt.Errorf("registry mirror must set both source and mirror")
+		}
+		w.mirrors = append(w.mirrors, mirror)
+	}
+	// the longest source is matched first
+	sort.SliceStable(w.mirrors, func(i, j int) bool {
+		return len(w.mirrors[i].Source) > len(w.mirrors[j].Source)
+	})
+
+	for _, override := range registry.Images {
+		if override.Name == "" {
+			return nil, fmt.Errorf("image override must set the name of the image")
+		}
+		if override.Image == "" && override.Digest == "" {
+			return nil, fmt.Errorf("image override %s must set the image or the digest", override.Name)
+		}
+		if override.Digest != "" && !digestPattern.MatchString(override.Digest) {
+			return nil, fmt.Errorf("invalid digest %q of image %s, it must be sha256:<64 hex characters>", override.Digest, override.Name)
+		}
+		if _, ok := w.overrides[override.Name]; ok {
+			return nil, fmt.Errorf("image %s is overridden more than once", override.Name)
+		}
+		w.overrides[override.Name] = override
+	}
+	return w, nil
+}
+
+// ValidateImageRegistry checks the mirrors, overrides, and digest pins
+func ValidateImageRegistry(registry *apiv3.ImageRegistry) error {
+	_, err := NewImageRewriter(registry)
+	return err
+}
+
+// imageRepository returns the image without its tag and digest
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// imageDigest returns the digest referenced by the image, if any
+func imageDigest(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// Rewrite applies the override of the image, then the longest matching
+// mirror. It returns an error when the image doesn't match its digest pin.
+func (w *ImageRewriter) Rewrite(image string) (string, error) {
+	if w == nil || image == "" {
+		return image, nil
+	}
+	repository := imageRepository(image)
+	pin := w.overrides[repository].Digest
+	if override, ok := w.overrides[repository]; ok && override.Image != "" {
+		image = override.Image
+	}
+
+	for _, mirror := range w.mirrors {
+		if image == mirror.Source || strings.HasPrefix(image, strings.TrimSuffix(mirror.Source, "/")+"/") {
+			image = strings.TrimSuffix(mirror.Mirror, "/") + strings.TrimPrefix(image, strings.TrimSuffix(mirror.Source, "/"))
+			break
+		}
+	}
+
+	// the pin applies to the original and the rewritten repository
+	if pin == "" {
+		pin = w.overrides[imageRepository(image)].Digest
+	}
+	if pin != "" {
+		digest := imageDigest(image)
+		if digest == "" {
+			return image, fmt.Errorf("image %s is not pinned to digest %s", image, pin)
+		}
+		if digest != pin {
+			return image, fmt.Errorf("image %s doesn't match the digest pin %s", image, pin)
+		}
+	}
+	return image, nil
+}
+
+// RewriteImages rewrites the images of a configuration in place, they are the
+// string values of the image and imageName keys. It returns a description of
+// each image that doesn't match its digest pin, the image is left unchanged.
+func (w *ImageRewriter) RewriteImages(path string, config interface{}) []string {
+	if w == nil {
+		return nil
+	}
+	var issues []string
+	switch config := config.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(config))
+		for key := range config {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if image, ok := config[key].(string); ok && imageKeys[key] {
+				rewritten, err := w.Rewrite(image)
+				if err != nil {
+					issues = append(issues, fmt.Sprintf("%s.%s: %v", path, key, err))
+					continue
+				}
+				config[key] = rewritten
+				continue
+			}
+			issues = append(issues, w.RewriteImages(path+"."+key, config[key])...)
+		}
+	case []interface{}:
+		for i, value := range config {
+			name := fmt.Sprintf("%s[%d]", path, i)
+			if item, ok := value.(map[string]interface{}); ok {
+				if itemName, ok := item["name"].(string); ok {
+					name = path + "." + itemName
+				}
+			}
+			issues = append(issues, w.RewriteImages(name, value)...)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var (
+	testDigest  = "sha256:" + strings.Repeat("a", 64)
+	otherDigest = "sha256:" + strings.Repeat("b", 64)
+)
+
+func TestNewImageRewriter_Validation(t *testing.T) {
+	rewriter, err := NewImageRewriter(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, rewriter)
+
+	for _, registry := range []*apiv3.ImageRegistry{
+		{Mirrors: []apiv3.RegistryMirror{{Source: "icr.io"}}},
+		{Images: []apiv3.ImageOverride{{Image: "mirror.example.com/utils:1.0"}}},
+		{Images: []apiv3.ImageOverride{{Name: "icr.io/cpopen/cpfs/cpfs-utils"}}},
+		{Images: []apiv3.ImageOverride{{Name: "icr.io/cpopen/cpfs/cpfs-utils", Digest: "sha256:abc"}}},
+		{Images: []apiv3.ImageOverride{
+			{Name: "icr.io/cpopen/cpfs/cpfs-utils", Digest: testDigest},
+			{Name: "icr.io/cpopen/cpfs/cpfs-utils", Image: "mirror.example.com/utils:1.0"},
+		}},
+	} {
+		assert.Error(t, ValidateImageRegistry(registry), "%+v", registry)
+	}
+}
+
+func TestImageRewriter_Rewrite(t *testing.T) {
+	rewriter, err := NewImageRewriter(&apiv3.ImageRegistry{
+		Mirrors: []apiv3.RegistryMirror{
+			{Source: "icr.io", Mirror: "mirror.example.com/icr"},
+			{Source: "icr.io/cpopen/", Mirror: "mirror.example.com/cpopen"},
+		},
+		Images: []apiv3.ImageOverride{
+			{Name: "icr.io/cpopen/cpfs/cpfs-utils", Image: "icr.io/cpopen/cpfs/cpfs-utils@" + testDigest, Digest: testDigest},
+			{Name: "mirror.example.com/icr/db2u/keycloak", Digest: testDigest},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		image    string
+		expected string
+		fails    bool
+	}{
+		// the longest mirror source wins
+		{"icr.io/cpopen/ibm-im-operator:4.5.0", "mirror.example.com/cpopen/ibm-im-operator:4.5.0", false},
+		{"icr.io/db2u/postgres:16", "mirror.example.com/icr/db2u/postgres:16", false},
+		// the source must match a path segment
+		{"icr.iox/db2u/postgres:16", "icr.iox/db2u/postgres:16", false},
+		{"quay.io/keycloak/keycloak:26", "quay.io/keycloak/keycloak:26", false},
+		// the override is applied before the mirror and pinned
+		{"icr.io/cpopen/cpfs/cpfs-utils:4.6.0", "mirror.example.com/cpopen/cpfs/cpfs-utils@" + testDigest, false},
+		// the pin applies to the mirrored repository
+		{"icr.io/db2u/keycloak@" + testDigest, "mirror.example.com/icr/db2u/keycloak@" + testDigest, false},
+		{"icr.io/db2u/keycloak:26", "", true},
+		{"icr.io/db2u/keycloak@" + otherDigest, "", true},
+	} {
+		image, err := rewriter.Rewrite(tc.image)
+		if tc.fails {
+			assert.Error(t, err, tc.image)
+			continue
+		}
+		assert.NoError(t, err, tc.image)
+		assert.Equal(t, tc.expected, image, tc.image)
+	}
+}
+
+func TestImageRewriter_RewriteImages(t *testing.T) {
+	rewriter, err := NewImageRewriter(&apiv3.ImageRegistry{
+		Mirrors: []apiv3.RegistryMirror{{Source: "icr.io", Mirror: "mirror.example.com"}},
+		Images:  []apiv3.ImageOverride{{Name: "icr.io/db2u/keycloak", Digest: testDigest}},
+	})
+	require.NoError(t, err)
+
+	templated := map[string]interface{}{"templatingValueFrom": map[string]interface{}{"configMapKeyRef": map[string]interface{}{"key": "image"}}}
+	services := []interface{}{
+		map[string]interface{}{
+			"name": "keycloak-operator",
+			"resources": []interface{}{
+				map[string]interface{}{
+					"name": "cs-keycloak-pre-upgrade-job",
+					"data": map[string]interface{}{"containers": []interface{}{
+						map[string]interface{}{"name": "job", "image": "icr.io/cpopen/cpfs/cpfs-utils:4.6.0"},
+					}},
+				},
+				map[string]interface{}{
+					"name": "cs-keycloak",
+					"data": map[string]interface{}{"image": "icr.io/db2u/keycloak:26", "imageName": templated},
+				},
+			},
+		},
+	}
+
+	issues := rewriter.RewriteImages("services", services)
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0], "services.keycloak-operator.resources.cs-keycloak.data.image")
+
+	resources := services[0].(map[string]interface{})["resources"].([]interface{})
+	job := resources[0].(map[string]interface{})["data"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "mirror.example.com/cpopen/cpfs/cpfs-utils:4.6.0", job["image"])
+	keycloak := resources[1].(map[string]interface{})["data"].(map[string]interface{})
+	assert.Equal(t, "icr.io/db2u/keycloak:26", keycloak["image"], "the image not matching its pin is left unchanged")
+	assert.Equal(t, templated, keycloak["imageName"])
+
+	var nilRewriter *ImageRewriter
+	assert.Empty(t, nilRewriter.RewriteImages("services", services))
+}
@@ -0,0 +1,218 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// maxMaintenanceWindow is the longest maintenance window, the start of the
+// window is looked up minute by minute
+const maxMaintenanceWindow = 7 * 24 * time.Hour
+
+// cronBounds are the ranges of the minute, hour, day of month, month and day
+// of week of a cron schedule, 7 is also Sunday
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// CronSchedule is a cron schedule with five fields
+type CronSchedule struct {
+	fields [5]map[int]bool
+	// the day of month or the day of week matches when both are restricted
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// ParseCronSchedule parses a cron schedule with five fields, each field is a
+// list of *, values, or ranges with an optional step, e.g. 0 2 * * 6 or */15
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronBounds) {
+		return nil, fmt.Errorf("invalid schedule %q, it must have five fields, e.g. \"0 2 * * 6\"", spec)
+	}
+	schedule := &CronSchedule{anyDayOfMonth: fields[2] == "*", anyDayOfWeek: fields[4] == "*"}
+	for i, field := range fields {
+		values, err := parseCronField(field, cronBounds[i][0], cronBounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %v", spec, err)
+		}
+		schedule.fields[i] = values
+	}
+	if schedule.fields[4][7] {
+		schedule.fields[4][0] = true
+	}
+	return schedule, nil
+}
+
+// parseCronField returns the values of a field of a cron schedule
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			part = part[:i]
+		}
+
+		low, high := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if low, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			switch {
+			case len(bounds) == 2:
+				if high, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+			case step == 1:
+				high = low
+			}
+		}
+		if low < min || high > max || low > high {
+			return nil, fmt.Errorf("%q is out of the range %d-%d", part, min, max)
+		}
+		for value := low; value <= high; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+// Matches returns true when the minute of the time matches the schedule
+func (s *CronSchedule) Matches(t time.Time) bool {
+	if !s.fields[0][t.Minute()] || !s.fields[1][t.Hour()] || !s.fields[3][int(t.Month())] {
+		return false
+	}
+	dayOfMonth, dayOfWeek := s.fields[2][t.Day()], s.fields[4][int(t.Weekday())]
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// ValidateMaintenanceWindow checks the schedule, the duration and the time
+// zone of the window
+func ValidateMaintenanceWindow(window *apiv3.MaintenanceWindow) error {
+	if _, err := ParseCronSchedule(window.Schedule); err != nil {
+		return err
+	}
+	if window.Duration.Duration < time.Minute || window.Duration.Duration > maxMaintenanceWindow {
+		return fmt.Errorf("invalid duration %v, it must be between 1m and %v", window.Duration.Duration, maxMaintenanceWindow)
+	}
+	if _, err := time.LoadLocation(window.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone %q: %v", window.TimeZone, err)
+	}
+	return nil
+}
+
+// InMaintenanceWindow returns true when the window has started within its
+// duration before the time
+func InMaintenanceWindow(window *apiv3.MaintenanceWindow, now time.Time) (bool, error) {
+	if err := ValidateMaintenanceWindow(window); err != nil {
+		return false, err
+	}
+	schedule, _ := ParseCronSchedule(window.Schedule)
+	location, _ := time.LoadLocation(window.TimeZone)
+
+	now = now.In(location).Truncate(time.Minute)
+	for start := now; now.Sub(start) < window.Duration.Duration; start = start.Add(-time.Minute) {
+		if schedule.Matches(start) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ValidateInstallPlanApprovalPolicy checks the maintenance window and the
+// allowed versions of the policy, the policy is only used when the
+// InstallPlans are approved manually
+func ValidateInstallPlanApprovalPolicy(policy *apiv3.InstallPlanApprovalPolicy, approval olmv1alpha1.Approval) error {
+	if policy == nil {
+		return nil
+	}
+	if approval != olmv1alpha1.ApprovalManual {
+		return fmt.Errorf("installPlanApproval must be Manual to approve the InstallPlans by the policy")
+	}
+	if err := ValidateMaintenanceWindow(&policy.MaintenanceWindow); err != nil {
+		return fmt.Errorf("maintenanceWindow is invalid: %v", err)
+	}
+	seen := map[string]bool{}
+	for _, allowed := range policy.AllowedVersions {
+		if allowed.Name == "" {
+			return fmt.Errorf("allowedVersions must set name")
+		}
+		if seen[allowed.Name] {
+			return fmt.Errorf("allowed versions of operator %s are set more than once", allowed.Name)
+		}
+		seen[allowed.Name] = true
+		if _, err := semver.ParseRange(allowed.Versions); err != nil {
+			return fmt.Errorf("invalid versions %q of operator %s: %v", allowed.Versions, allowed.Name, err)
+		}
+	}
+	return nil
+}
+
+// ParseCSVName returns the operator name and the version of a CSV name, e.g.
+// ibm-im-operator and 4.10.0 of ibm-im-operator.v4.10.0
+func ParseCSVName(csvName string) (string, semver.Version, error) {
+	// the version is after the first ".v" followed by a valid version
+	for i := strings.Index(csvName, ".v"); i >= 0; {
+		if version, err := semver.ParseTolerant(csvName[i+2:]); err == nil {
+			return csvName[:i], version, nil
+		}
+		next := strings.Index(csvName[i+2:], ".v")
+		if next < 0 {
+			break
+		}
+		i += next + 2
+	}
+	return "", semver.Version{}, fmt.Errorf("CSV %s has no valid version", csvName)
+}
+
+// CSVAllowed returns true when the version of the CSV is in the allowed
+// versions of its operator
+func CSVAllowed(policy *apiv3.InstallPlanApprovalPolicy, csvName string) (bool, error) {
+	if len(policy.AllowedVersions) == 0 {
+		return true, nil
+	}
+	name, version, err := ParseCSVName(csvName)
+	if err != nil {
+		return false, err
+	}
+	for _, allowed := range policy.AllowedVersions {
+		if allowed.Name != name {
+			continue
+		}
+		versions, err := semver.ParseRange(allowed.Versions)
+		if err != nil {
+			return false, err
+		}
+		return versions(version), nil
+	}
+	return false, nil
+}
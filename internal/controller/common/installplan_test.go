@@ -0,0 +1,112 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestCronSchedule(t *testing.T) {
+	schedule, err := ParseCronSchedule("0 2 * * 6")
+	assert.NoError(t, err)
+	assert.True(t, schedule.Matches(time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)))
+	assert.False(t, schedule.Matches(time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC)))
+	assert.False(t, schedule.Matches(time.Date(2026, 10, 17, 2, 1, 0, 0, time.UTC)))
+
+	// Sunday is 0 or 7, the day of month or the day of week matches
+	schedule, err = ParseCronSchedule("*/15 1-3 1,15 * 7")
+	assert.NoError(t, err)
+	assert.True(t, schedule.Matches(time.Date(2026, 10, 18, 3, 45, 0, 0, time.UTC)))
+	assert.True(t, schedule.Matches(time.Date(2026, 10, 15, 1, 30, 0, 0, time.UTC)))
+	assert.False(t, schedule.Matches(time.Date(2026, 10, 16, 1, 30, 0, 0, time.UTC)))
+	assert.False(t, schedule.Matches(time.Date(2026, 10, 18, 3, 50, 0, 0, time.UTC)))
+
+	for _, spec := range []string{"", "0 2 * *", "60 * * * *", "0 2 * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := ParseCronSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	window := &apiv3.MaintenanceWindow{Schedule: "0 22 * * 5", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "America/Toronto"}
+	// Friday 22:00 in Toronto is Saturday 02:00 UTC
+	for at, expected := range map[time.Time]bool{
+		time.Date(2026, 10, 17, 1, 59, 0, 0, time.UTC): false,
+		time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC):  true,
+		time.Date(2026, 10, 17, 5, 59, 0, 0, time.UTC): true,
+		time.Date(2026, 10, 17, 6, 0, 0, 0, time.UTC):  false,
+	} {
+		open, err := InMaintenanceWindow(window, at)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, open, at.String())
+	}
+
+	window.TimeZone = "Mars/Olympus"
+	_, err := InMaintenanceWindow(window, time.Now())
+	assert.Error(t, err)
+}
+
+func TestValidateInstallPlanApprovalPolicy(t *testing.T) {
+	policy := &apiv3.InstallPlanApprovalPolicy{
+		MaintenanceWindow: apiv3.MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: time.Hour}},
+		AllowedVersions:   []apiv3.OperatorVersionRange{{Name: "ibm-im-operator", Versions: ">=4.10.0 <4.12.0"}},
+	}
+	assert.NoError(t, ValidateInstallPlanApprovalPolicy(nil, olmv1alpha1.ApprovalAutomatic))
+	assert.NoError(t, ValidateInstallPlanApprovalPolicy(policy, olmv1alpha1.ApprovalManual))
+	assert.Error(t, ValidateInstallPlanApprovalPolicy(policy, olmv1alpha1.ApprovalAutomatic))
+
+	policy.MaintenanceWindow.Duration.Duration = 8 * 24 * time.Hour
+	assert.Error(t, ValidateInstallPlanApprovalPolicy(policy, olmv1alpha1.ApprovalManual))
+	policy.MaintenanceWindow.Duration.Duration = time.Hour
+
+	policy.AllowedVersions = append(policy.AllowedVersions, apiv3.OperatorVersionRange{Name: "ibm-im-operator", Versions: "4.12.0"})
+	assert.Error(t, ValidateInstallPlanApprovalPolicy(policy, olmv1alpha1.ApprovalManual))
+	policy.AllowedVersions[1] = apiv3.OperatorVersionRange{Name: "ibm-mcsp-operator", Versions: "latest"}
+	assert.Error(t, ValidateInstallPlanApprovalPolicy(policy, olmv1alpha1.ApprovalManual))
+}
+
+func TestCSVAllowed(t *testing.T) {
+	name, version, err := ParseCSVName("operand-deployment-lifecycle-manager.v4.3.10")
+	assert.NoError(t, err)
+	assert.Equal(t, "operand-deployment-lifecycle-manager", name)
+	assert.Equal(t, "4.3.10", version.String())
+	_, _, err = ParseCSVName("ibm-im-operator")
+	assert.Error(t, err)
+
+	policy := &apiv3.InstallPlanApprovalPolicy{}
+	allowed, err := CSVAllowed(policy, "ibm-im-operator.v4.12.0")
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	policy.AllowedVersions = []apiv3.OperatorVersionRange{{Name: "ibm-im-operator", Versions: ">=4.10.0 <4.12.0"}}
+	for csv, expected := range map[string]bool{
+		"ibm-im-operator.v4.11.2":                      true,
+		"ibm-im-operator.v4.12.0":                      false,
+		"operand-deployment-lifecycle-manager.v4.3.10": false,
+	} {
+		allowed, err := CSVAllowed(policy, csv)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, allowed, csv)
+	}
+}
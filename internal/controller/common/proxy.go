@@ -0,0 +1,103 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// Proxy environment variables
+const (
+	HTTPProxyEnvVar  = "HTTP_PROXY"
+	HTTPSProxyEnvVar = "HTTPS_PROXY"
+	NoProxyEnvVar    = "NO_PROXY"
+)
+
+// defaultNoProxy are the in-cluster destinations that are never proxied
+var defaultNoProxy = []string{"localhost", "127.0.0.1", ".svc", ".cluster.local"}
+
+// ValidateProxy checks that the proxy URLs are absolute http or https URLs
+func ValidateProxy(proxy *apiv3.Proxy) error {
+	if proxy == nil {
+		return nil
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{{"httpProxy", proxy.HTTPProxy}, {"httpsProxy", proxy.HTTPSProxy}} {
+		if field.value == "" {
+			continue
+		}
+		proxyURL, err := url.Parse(field.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %v", field.name, field.value, err)
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" || proxyURL.Host == "" {
+			return fmt.Errorf("invalid %s %q: it must be an http or https URL", field.name, field.value)
+		}
+	}
+	return nil
+}
+
+// NoProxy returns the NO_PROXY entries of the proxy followed by the in-cluster
+// destinations and the service domains of the namespaces, without duplicates
+func NoProxy(proxy *apiv3.Proxy, namespaces ...string) string {
+	var entries []string
+	if proxy != nil {
+		entries = append(entries, strings.Split(proxy.NoProxy, ",")...)
+	}
+	entries = append(entries, defaultNoProxy...)
+	for _, ns := range namespaces {
+		if ns != "" {
+			entries = append(entries, "."+ns, "."+ns+".svc", "."+ns+".svc.cluster.local")
+		}
+	}
+
+	seen := map[string]bool{}
+	var noProxy []string
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || seen[entry] {
+			continue
+		}
+		seen[entry] = true
+		noProxy = append(noProxy, entry)
+	}
+	return strings.Join(noProxy, ",")
+}
+
+// ProxyEnv returns the proxy environment variables, it returns nil when no
+// proxy URL is set
+func ProxyEnv(proxy *apiv3.Proxy, namespaces ...string) []corev1.EnvVar {
+	if proxy == nil || proxy.HTTPProxy == "" && proxy.HTTPSProxy == "" {
+		return nil
+	}
+	var env []corev1.EnvVar
+	if proxy.HTTPProxy != "" {
+		env = append(env, corev1.EnvVar{Name: HTTPProxyEnvVar, Value: proxy.HTTPProxy})
+	}
+	if proxy.HTTPSProxy != "" {
+		env = append(env, corev1.EnvVar{Name: HTTPSProxyEnvVar, Value: proxy.HTTPSProxy})
+	}
+	return append(env, corev1.EnvVar{Name: NoProxyEnvVar, Value: NoProxy(proxy, namespaces...)})
+}
@@ -0,0 +1,215 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// TLSProfileSpec is the minimum TLS version and the ciphers of a profile
+type TLSProfileSpec struct {
+	MinTLSVersion string
+	Ciphers       []string
+}
+
+// tlsProfiles are the predefined profiles, they are the same as the profiles
+// of the OpenShift APIServer
+var tlsProfiles = map[string]TLSProfileSpec{
+	apiv3.TLSProfileOld: {
+		MinTLSVersion: "VersionTLS10",
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+			"ECDHE-ECDSA-AES128-GCM-SHA256",
+			"ECDHE-RSA-AES128-GCM-SHA256",
+			"ECDHE-ECDSA-AES256-GCM-SHA384",
+			"ECDHE-RSA-AES256-GCM-SHA384",
+			"ECDHE-ECDSA-CHACHA20-POLY1305",
+			"ECDHE-RSA-CHACHA20-POLY1305",
+			"DHE-RSA-AES128-GCM-SHA256",
+			"DHE-RSA-AES256-GCM-SHA384",
+			"DHE-RSA-CHACHA20-POLY1305",
+			"ECDHE-ECDSA-AES128-SHA256",
+			"ECDHE-RSA-AES128-SHA256",
+			"ECDHE-ECDSA-AES128-SHA",
+			"ECDHE-RSA-AES128-SHA",
+			"ECDHE-ECDSA-AES256-SHA384",
+			"ECDHE-RSA-AES256-SHA384",
+			"ECDHE-ECDSA-AES256-SHA",
+			"ECDHE-RSA-AES256-SHA",
+			"DHE-RSA-AES128-SHA256",
+			"DHE-RSA-AES256-SHA256",
+			"AES128-GCM-SHA256",
+			"AES256-GCM-SHA384",
+			"AES128-SHA256",
+			"AES256-SHA256",
+			"AES128-SHA",
+			"AES256-SHA",
+			"DES-CBC3-SHA",
+		},
+	},
+	apiv3.TLSProfileIntermediate: {
+		MinTLSVersion: "VersionTLS12",
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+			"ECDHE-ECDSA-AES128-GCM-SHA256",
+			"ECDHE-RSA-AES128-GCM-SHA256",
+			"ECDHE-ECDSA-AES256-GCM-SHA384",
+			"ECDHE-RSA-AES256-GCM-SHA384",
+			"ECDHE-ECDSA-CHACHA20-POLY1305",
+			"ECDHE-RSA-CHACHA20-POLY1305",
+			"DHE-RSA-AES128-GCM-SHA256",
+			"DHE-RSA-AES256-GCM-SHA384",
+		},
+	},
+	apiv3.TLSProfileModern: {
+		MinTLSVersion: "VersionTLS13",
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+		},
+	},
+}
+
+// postgresTLSVersions maps the TLS versions to the values of the PostgreSQL
+// ssl_min_protocol_version parameter
+var postgresTLSVersions = map[string]string{
+	"VersionTLS10": "TLSv1",
+	"VersionTLS11": "TLSv1.1",
+	"VersionTLS12": "TLSv1.2",
+	"VersionTLS13": "TLSv1.3",
+}
+
+// tlsVersions are the TLS versions from the newest
+var tlsVersions = []string{"VersionTLS13", "VersionTLS12", "VersionTLS11", "VersionTLS10"}
+
+// ianaCiphers maps the OpenSSL names of the ciphers below TLS 1.3 to their
+// IANA names, which Keycloak uses
+var ianaCiphers = map[string]string{
+	"ECDHE-ECDSA-AES128-GCM-SHA256": "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-RSA-AES128-GCM-SHA256":   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-ECDSA-AES256-GCM-SHA384": "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-RSA-AES256-GCM-SHA384":   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-ECDSA-CHACHA20-POLY1305": "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-RSA-CHACHA20-POLY1305":   "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	"DHE-RSA-AES128-GCM-SHA256":     "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256",
+	"DHE-RSA-AES256-GCM-SHA384":     "TLS_DHE_RSA_WITH_AES_256_GCM_SHA384",
+	"DHE-RSA-CHACHA20-POLY1305":     "TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-ECDSA-AES128-SHA256":     "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-RSA-AES128-SHA256":       "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-ECDSA-AES128-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-RSA-AES128-SHA":          "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-ECDSA-AES256-SHA384":     "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384",
+	"ECDHE-RSA-AES256-SHA384":       "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384",
+	"ECDHE-ECDSA-AES256-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	"ECDHE-RSA-AES256-SHA":          "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	"DHE-RSA-AES128-SHA256":         "TLS_DHE_RSA_WITH_AES_128_CBC_SHA256",
+	"DHE-RSA-AES256-SHA256":         "TLS_DHE_RSA_WITH_AES_256_CBC_SHA256",
+	"AES128-GCM-SHA256":             "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	"AES256-GCM-SHA384":             "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	"AES128-SHA256":                 "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	"AES256-SHA256":                 "TLS_RSA_WITH_AES_256_CBC_SHA256",
+	"AES128-SHA":                    "TLS_RSA_WITH_AES_128_CBC_SHA",
+	"AES256-SHA":                    "TLS_RSA_WITH_AES_256_CBC_SHA",
+	"DES-CBC3-SHA":                  "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+}
+
+// ValidateTLSSecurityProfile checks the type of the profile, and the minimum
+// TLS version and the ciphers of a Custom profile
+func ValidateTLSSecurityProfile(profile *apiv3.TLSSecurityProfile) error {
+	_, err := ResolveTLSProfile(profile)
+	return err
+}
+
+// ResolveTLSProfile returns the minimum TLS version and the ciphers of the
+// profile, the Intermediate profile is used when the type is not set
+func ResolveTLSProfile(profile *apiv3.TLSSecurityProfile) (TLSProfileSpec, error) {
+	if profile == nil || profile.Type == "" {
+		return tlsProfiles[apiv3.TLSProfileIntermediate], nil
+	}
+	if spec, ok := tlsProfiles[profile.Type]; ok {
+		return spec, nil
+	}
+	if profile.Type != apiv3.TLSProfileCustom {
+		return TLSProfileSpec{}, fmt.Errorf("unknown TLS security profile type %q", profile.Type)
+	}
+
+	if profile.Custom == nil {
+		return TLSProfileSpec{}, fmt.Errorf("the Custom TLS security profile must set custom")
+	}
+	if _, ok := postgresTLSVersions[profile.Custom.MinTLSVersion]; !ok {
+		return TLSProfileSpec{}, fmt.Errorf("unknown minTLSVersion %q of the Custom TLS security profile", profile.Custom.MinTLSVersion)
+	}
+	if len(profile.Custom.Ciphers) == 0 {
+		return TLSProfileSpec{}, fmt.Errorf("the Custom TLS security profile must set the ciphers")
+	}
+	return TLSProfileSpec{MinTLSVersion: profile.Custom.MinTLSVersion, Ciphers: profile.Custom.Ciphers}, nil
+}
+
+// PostgresMinTLSVersion returns the ssl_min_protocol_version of the profile
+func (s TLSProfileSpec) PostgresMinTLSVersion() string {
+	return postgresTLSVersions[s.MinTLSVersion]
+}
+
+// PostgresCiphers returns the ssl_ciphers of the profile, they are the ciphers
+// below TLS 1.3 separated by colons. It is empty when the profile only has TLS
+// 1.3 cipher suites, which PostgreSQL doesn't configure.
+func (s TLSProfileSpec) PostgresCiphers() string {
+	var ciphers []string
+	for _, cipher := range s.Ciphers {
+		if !strings.HasPrefix(cipher, "TLS_") {
+			ciphers = append(ciphers, cipher)
+		}
+	}
+	return strings.Join(ciphers, ":")
+}
+
+// KeycloakProtocols returns the https-protocols option of Keycloak, they are
+// the TLS versions from the newest down to the minimum version separated by
+// commas
+func (s TLSProfileSpec) KeycloakProtocols() string {
+	var protocols []string
+	for _, version := range tlsVersions {
+		protocols = append(protocols, postgresTLSVersions[version])
+		if version == s.MinTLSVersion {
+			break
+		}
+	}
+	return strings.Join(protocols, ",")
+}
+
+// KeycloakCipherSuites returns the https-cipher-suites option of Keycloak, they
+// are the IANA names of the ciphers separated by commas. The ciphers without an
+// IANA name are left out.
+func (s TLSProfileSpec) KeycloakCipherSuites() string {
+	var ciphers []string
+	for _, cipher := range s.Ciphers {
+		if strings.HasPrefix(cipher, "TLS_") {
+			ciphers = append(ciphers, cipher)
+		} else if name, ok := ianaCiphers[cipher]; ok {
+			ciphers = append(ciphers, name)
+		}
+	}
+	return strings.Join(ciphers, ",")
+}
@@ -0,0 +1,68 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestValidateTLSSecurityProfile(t *testing.T) {
+	assert.NoError(t, ValidateTLSSecurityProfile(nil))
+	assert.NoError(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileModern}))
+	assert.NoError(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileCustom,
+		Custom: &apiv3.CustomTLSProfile{MinTLSVersion: "VersionTLS12", Ciphers: []string{"ECDHE-RSA-AES128-GCM-SHA256"}}}))
+	assert.Error(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: "Strict"}))
+	assert.Error(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileCustom}))
+	assert.Error(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileCustom,
+		Custom: &apiv3.CustomTLSProfile{MinTLSVersion: "TLSv1.2", Ciphers: []string{"ECDHE-RSA-AES128-GCM-SHA256"}}}))
+	assert.Error(t, ValidateTLSSecurityProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileCustom,
+		Custom: &apiv3.CustomTLSProfile{MinTLSVersion: "VersionTLS12"}}))
+}
+
+func TestResolveTLSProfile(t *testing.T) {
+	spec, err := ResolveTLSProfile(&apiv3.TLSSecurityProfile{})
+	assert.NoError(t, err)
+	assert.Equal(t, "TLSv1.2", spec.PostgresMinTLSVersion())
+	assert.Equal(t, "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256:ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384:"+
+		"ECDHE-ECDSA-CHACHA20-POLY1305:ECDHE-RSA-CHACHA20-POLY1305:DHE-RSA-AES128-GCM-SHA256:DHE-RSA-AES256-GCM-SHA384", spec.PostgresCiphers())
+
+	spec, err = ResolveTLSProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileModern})
+	assert.NoError(t, err)
+	assert.Equal(t, "TLSv1.3", spec.PostgresMinTLSVersion())
+	assert.Empty(t, spec.PostgresCiphers())
+
+	spec, err = ResolveTLSProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileOld})
+	assert.NoError(t, err)
+	assert.Equal(t, "TLSv1", spec.PostgresMinTLSVersion())
+}
+
+func TestKeycloakTLSOptions(t *testing.T) {
+	spec, err := ResolveTLSProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileModern})
+	assert.NoError(t, err)
+	assert.Equal(t, "TLSv1.3", spec.KeycloakProtocols())
+	assert.Equal(t, "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384,TLS_CHACHA20_POLY1305_SHA256", spec.KeycloakCipherSuites())
+
+	spec, err = ResolveTLSProfile(&apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileCustom,
+		Custom: &apiv3.CustomTLSProfile{MinTLSVersion: "VersionTLS11", Ciphers: []string{"ECDHE-RSA-AES128-GCM-SHA256", "UNKNOWN-CIPHER"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "TLSv1.3,TLSv1.2,TLSv1.1", spec.KeycloakProtocols())
+	assert.Equal(t, "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", spec.KeycloakCipherSuites())
+}
@@ -480,6 +480,19 @@ func GetApprovalModeinNs(r client.Reader, ns string) (approvalMode string, err e
 	return
 }
 
+// GetInstallMode returns the install mode set by the INSTALL_MODE environment
+// variable, NO_OLM=true is the no-OLM mode for backward compatibility
+func GetInstallMode() string {
+	if os.Getenv("NO_OLM") == "true" {
+		return constant.InstallModeNoOLM
+	}
+	switch mode := os.Getenv("INSTALL_MODE"); mode {
+	case constant.InstallModeNoOLM, constant.InstallModeOLMv1:
+		return mode
+	}
+	return constant.InstallModeOLM
+}
+
 // GetCatalogSource gets CatalogSource will be used by operators
 func GetCatalogSource(packageName, ns string, r client.Reader) (CatalogSourceName, CatalogSourceNS string) {
 	subList := &olmv1alpha1.SubscriptionList{}
@@ -1000,3 +1013,19 @@ func GetBaseOperandConfigList() []string {
 		constant.CommonServicePGMigratorOpCon,
 	}
 }
+
+// GetBaseOperandRegistryList returns the standard list of OperandRegistry
+// templates inserted into the base OperandRegistry.
+func GetBaseOperandRegistryList() []string {
+	return []string{
+		constant.CSV4OpReg,
+		constant.MongoDBOpReg,
+		constant.IMOpReg,
+		constant.IdpConfigUIOpReg,
+		constant.PlatformUIOpReg,
+		constant.KeyCloakOpReg,
+		constant.CommonServicePGOpReg,
+		constant.CommonServiceCNPGOpReg,
+		constant.CommonServicePGMigratorOpReg,
+	}
+}
@@ -87,7 +87,25 @@ func (r *CommonServiceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		klog.Error("Accept license by changing .spec.license.accept to true in the CommonService CR. Operator will not proceed until then")
 	}
 
-	if os.Getenv("NO_OLM") == "true" {
+	// Register the user-defined size profiles before any size configuration is resolved
+	if err := r.Bootstrap.LoadSizeProfiles(ctx); err != nil {
+		klog.Errorf("Failed to load size profiles: %v", err)
+		return ctrl.Result{}, err
+	}
+	if err := r.Bootstrap.LoadTemplateOverlays(ctx); err != nil {
+		klog.Errorf("Failed to load template overlays: %v", err)
+		return ctrl.Result{}, err
+	}
+	if err := r.Bootstrap.LoadCustomOperators(ctx); err != nil {
+		klog.Errorf("Failed to load custom operators: %v", err)
+		return ctrl.Result{}, err
+	}
+	if err := r.Bootstrap.RestoreAutoSize(ctx); err != nil {
+		klog.Errorf("Failed to restore auto size: %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if !r.Bootstrap.UsesSubscriptions() {
 		klog.Infof("Reconciling CommonService: %s in No OLM environment", req.NamespacedName)
 		return r.NoOLMReconcile(ctx, req, instance)
 	}
@@ -147,11 +165,43 @@ func (r *CommonServiceReconciler) ReconcileMasterCR(ctx context.Context, instanc
 	r.Bootstrap.CSData.CatalogSourceNs = string(instance.Status.ConfigStatus.CatalogNamespace)
 	r.Bootstrap.CSData.ImagePullSecret = instance.GetImagePullSecret()
 
+	// Choose the profile of the auto size before the size configurations are resolved
+	if err := r.Bootstrap.EvaluateAutoSize(ctx, instance); err != nil {
+		klog.Warningf("Failed to evaluate auto size: %v", err)
+	}
+
 	var forceUpdateODLMCRs bool
 	if !reflect.DeepEqual(originalInstance.Status, instance.Status) {
 		forceUpdateODLMCRs = true
 	}
 
+	// Report the last successful backup of common-service-db, it doesn't
+	// change the ODLM CRs
+	if err := r.Bootstrap.UpdateBackupStatus(ctx, instance); err != nil {
+		klog.Warningf("Failed to update backup status: %v", err)
+	}
+
+	// Move the restore of common-service-db to its next phase, the recovery
+	// bootstrap is added to the OperandConfig once the restore is started
+	if err := r.Bootstrap.ReconcileRestore(ctx, instance); err != nil {
+		klog.Warningf("Failed to reconcile restore: %v", err)
+	}
+
+	// Record the role and the replication of common-service-db
+	if err := r.Bootstrap.ReconcilePostgreSQLReplica(ctx, instance); err != nil {
+		klog.Warningf("Failed to reconcile PostgreSQL replica: %v", err)
+	}
+
+	// Approve the pending InstallPlans of the pinned CSVs
+	if err := r.Bootstrap.ApprovePinnedInstallPlans(ctx, instance); err != nil {
+		klog.Warningf("Failed to approve InstallPlans of pinned CSVs: %v", err)
+	}
+
+	// Approve the pending InstallPlans allowed by the approval policy
+	if err := r.Bootstrap.ApproveInstallPlans(ctx, instance); err != nil {
+		klog.Warningf("Failed to approve InstallPlans: %v", err)
+	}
+
 	if statusErr = r.Client.Status().Patch(ctx, instance, client.MergeFrom(originalInstance)); statusErr != nil {
 		return ctrl.Result{}, fmt.Errorf("error while patching CommonService.Status: %v", statusErr)
 	}
@@ -275,6 +325,15 @@ func (r *CommonServiceReconciler) ReconcileMasterCR(ctx context.Context, instanc
 		return ctrl.Result{}, statusErr
 	}
 
+	// Create the NetworkPolicies of the services namespace when they are enabled
+	if statusErr = r.reconcileNetworkPolicies(ctx, instance.Spec.NetworkPolicy); statusErr != nil {
+		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
+			klog.Error(err)
+		}
+		klog.Errorf("Failed to reconcile NetworkPolicies: %v", statusErr)
+		return ctrl.Result{}, statusErr
+	}
+
 	var isEqual bool
 	if isEqual, statusErr = r.updateOperatorConfig(ctx, instance.Spec.OperatorConfigs); statusErr != nil {
 		if statusErr := r.updatePhase(ctx, instance, apiv3.CRFailed); statusErr != nil {
@@ -323,6 +382,26 @@ func (r *CommonServiceReconciler) ReconcileMasterCR(ctx context.Context, instanc
 	}
 
 	klog.Infof("Finished reconciling CommonService: %s/%s", instance.Namespace, instance.Name)
+	if restore := instance.Status.Restore; restore != nil && restore.Phase != apiv3.RestoreCompleted {
+		// check the progress of the restore
+		return ctrl.Result{RequeueAfter: constant.RequeueDuration}, nil
+	}
+	if bootstrap.ReplicaTransitionInProgress(instance) {
+		// check the progress of the promotion or the demotion
+		return ctrl.Result{RequeueAfter: constant.RequeueDuration}, nil
+	}
+	if instance.Spec.InstallPlanApprovalPolicy != nil {
+		// check the pending InstallPlans and the maintenance window again
+		return ctrl.Result{RequeueAfter: constant.InstallPlanApprovalInterval}, nil
+	}
+	if instance.Spec.CSPostgreSQLReplica != nil {
+		// check the replication again to report its lag
+		return ctrl.Result{RequeueAfter: constant.ReplicaStatusInterval}, nil
+	}
+	if instance.Spec.Backup != nil {
+		// check the backups again to report when they go stale
+		return ctrl.Result{RequeueAfter: constant.BackupStatusInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -402,6 +481,11 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 		return ctrl.Result{}, err
 	}
 
+	// Record the role and the replication of common-service-db
+	if err := r.Bootstrap.ReconcilePostgreSQLReplica(ctx, instance); err != nil {
+		klog.Warningf("Failed to reconcile PostgreSQL replica: %v", err)
+	}
+
 	// Set Ready condition
 	instance.SetReadyCondition(constant.KindCR, apiv3.ConditionTypeReady, corev1.ConditionTrue)
 	if err := r.Client.Status().Update(ctx, instance); err != nil {
@@ -415,6 +499,14 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 	}
 
 	klog.Infof("Finished reconciling CommonService: %s/%s", instance.Namespace, instance.Name)
+	if bootstrap.ReplicaTransitionInProgress(instance) {
+		// check the progress of the promotion or the demotion
+		return ctrl.Result{RequeueAfter: constant.RequeueDuration}, nil
+	}
+	if instance.Spec.CSPostgreSQLReplica != nil {
+		// check the replication again to report its lag
+		return ctrl.Result{RequeueAfter: constant.ReplicaStatusInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -465,9 +557,11 @@ func (r *CommonServiceReconciler) mappingToCsRequestForConfigMaps(ctx context.Co
 		return nil
 	}
 
-	// Check two configmaps: common-service-maps and ibm-cpp-config
+	// Check configmaps: common-service-maps, ibm-cpp-config and the size profiles
 	if (configMap.Name == constant.CsMapConfigMap && configMap.Namespace == constant.CsMapConfigMapNs) ||
-		(configMap.Name == constant.IBMCPPCONFIG && configMap.Namespace == r.Bootstrap.CSData.ServicesNs) {
+		(configMap.Name == constant.IBMCPPCONFIG && configMap.Namespace == r.Bootstrap.CSData.ServicesNs) ||
+		(configMap.Labels[constant.SizeProfileLabel] == "true" && configMap.Namespace == r.Bootstrap.CSData.OperatorNs) ||
+		(configMap.Labels[constant.TemplateOverlayLabel] == "true" && configMap.Namespace == r.Bootstrap.CSData.OperatorNs) {
 		return []reconcile.Request{
 			{NamespacedName: types.NamespacedName{
 				Name:      constant.MasterCR,
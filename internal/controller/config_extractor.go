@@ -27,6 +27,7 @@ import (
 	"k8s.io/klog"
 
 	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
 )
@@ -48,7 +49,7 @@ func ExtractCommonServiceConfigs(
 	var newConfigs []interface{}
 
 	// Extract feature configurations
-	featureConfigs, err := extractFeatureConfigs(cs)
+	featureConfigs, err := extractFeatureConfigs(cs, servicesNs)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +81,7 @@ func ExtractServiceSpecificConfigs(
 }
 
 // extractFeatureConfigs handles feature flag extraction
-func extractFeatureConfigs(cs *apiv3.CommonService) ([]interface{}, error) {
+func extractFeatureConfigs(cs *apiv3.CommonService, servicesNs string) ([]interface{}, error) {
 	var configs []interface{}
 
 	// Extract storageClass configuration
@@ -193,6 +194,16 @@ func extractFeatureConfigs(cs *apiv3.CommonService) ([]interface{}, error) {
 		configs = append(configs, storageConfig...)
 	}
 
+	// Extract per-service storage configuration, it overrides the storageClass
+	if len(cs.Spec.Storage) > 0 {
+		klog.Info("Extracting per-service storage configuration")
+		storageConfig, err := renderServiceStorage(cs.Spec.Storage)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, storageConfig...)
+	}
+
 	// Extract labels configuration
 	if cs.Spec.Labels != nil && len(cs.Spec.Labels) > 0 {
 		klog.Info("Extracting label configuration")
@@ -206,6 +217,50 @@ func extractFeatureConfigs(cs *apiv3.CommonService) ([]interface{}, error) {
 		}
 	}
 
+	// Extract pod placement configuration
+	if cs.Spec.Placement != nil {
+		klog.Info("Extracting pod placement configuration")
+		placementConfig, err := renderPodPlacement(cs.Spec.Placement)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, placementConfig...)
+	}
+
+	// Extract priority class configuration
+	if cs.Spec.PriorityClassName != "" {
+		klog.Info("Extracting priority class configuration")
+		priorityClassConfig, err := convertStringToSlice(constant.RenderPriorityClassTemplate(cs.Spec.PriorityClassName))
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, priorityClassConfig...)
+	}
+
+	// Extract proxy configuration
+	if env := util.ProxyEnv(cs.Spec.Proxy, servicesNs, cs.Namespace); len(env) > 0 {
+		klog.Info("Extracting proxy configuration")
+		envBytes, err := json.Marshal(env)
+		if err != nil {
+			return nil, err
+		}
+		proxyConfig, err := convertStringToSlice(strings.ReplaceAll(constant.ProxyTemplate, "placeholder", string(envBytes)))
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, proxyConfig...)
+	}
+
+	// Extract TLS security profile configuration
+	if cs.Spec.TLSSecurityProfile != nil {
+		klog.Info("Extracting TLS security profile configuration")
+		tlsConfig, err := renderTLSProfile(cs.Spec.TLSSecurityProfile)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, tlsConfig...)
+	}
+
 	// Extract CSPostgreSQLReplica configuration
 	if cs.Spec.CSPostgreSQLReplica != nil {
 		klog.Info("Extracting CSPostgreSQLReplica configuration")
@@ -216,6 +271,27 @@ func extractFeatureConfigs(cs *apiv3.CommonService) ([]interface{}, error) {
 		configs = append(configs, replicaConfig)
 	}
 
+	// Extract backup configuration
+	if cs.Spec.Backup != nil {
+		klog.Info("Extracting backup configuration")
+		backupConfigs, err := extractBackupConfigs(cs.Spec.Backup)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract backup config: %v", err)
+		}
+		configs = append(configs, backupConfigs...)
+	}
+
+	// Extract restore configuration once the consumers of common-service-db
+	// are scaled down
+	if restoreStarted(cs) {
+		klog.Info("Extracting restore configuration")
+		restoreConfigs, err := extractRestoreConfigs(cs.Spec.Restore)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract restore config: %v", err)
+		}
+		configs = append(configs, restoreConfigs...)
+	}
+
 	return configs, nil
 }
 
@@ -246,6 +322,14 @@ func extractPostgreSQLReplicaConfig(replicaConfig *apiv3.CSPostgreSQLReplicaConf
 		return nil, err
 	}
 
+	// The action is not a field of the Cluster, it sets replica.enabled
+	delete(replicaMap, "action")
+	if replicaConfig.Action != "" {
+		if replica, ok := replicaMap["replica"].(map[string]interface{}); ok {
+			replica["enabled"] = replicaConfig.Action == apiv3.ReplicaActionDemote
+		}
+	}
+
 	// Explicitly set bootstrap.initdb to nil to remove it from base template during merge
 	// Replica clusters should only have bootstrap.pg_basebackup, not bootstrap.initdb
 	if bootstrap, ok := replicaMap["bootstrap"].(map[string]interface{}); ok {
@@ -286,16 +370,10 @@ func extractSizeConfigs(
 	var sizeConfigs []interface{}
 	var err error
 
-	switch cs.Spec.Size {
-	case "starterset", "starter":
-		sizeConfigs, serviceControllerMapping, err = extractSizeTemplate(cs, size.StarterSet, serviceControllerMapping, servicesNs)
-	case "small":
-		sizeConfigs, serviceControllerMapping, err = extractSizeTemplate(cs, size.Small, serviceControllerMapping, servicesNs)
-	case "medium":
-		sizeConfigs, serviceControllerMapping, err = extractSizeTemplate(cs, size.Medium, serviceControllerMapping, servicesNs)
-	case "large", "production":
-		sizeConfigs, serviceControllerMapping, err = extractSizeTemplate(cs, size.Large, serviceControllerMapping, servicesNs)
-	default:
+	// Built-in and user-defined size profiles are both resolved by name
+	if sizeTemplate, ok := size.Lookup(cs.Spec.Size); ok {
+		sizeConfigs, serviceControllerMapping, err = extractSizeTemplate(cs, sizeTemplate, serviceControllerMapping, servicesNs)
+	} else {
 		sizeConfigs, serviceControllerMapping = extractCustomSizeConfigs(cs, serviceControllerMapping)
 	}
 
@@ -376,6 +454,13 @@ func extractSizeTemplate(cs *apiv3.CommonService, sizeTemplate string, serviceCo
 		return nil, nil, err
 	}
 
+	// Scale the size template before the custom services are merged, so that
+	// the values set explicitly in the CR are kept as they are
+	if err := applySizeFactors(sizes, cs.Spec.SizeFactors); err != nil {
+		klog.Errorf("apply size factors: %v", err)
+		return nil, nil, err
+	}
+
 	// Merge size template with custom services using the same logic as applySizeTemplate
 	for i, configSize := range sizes {
 		if configSize == nil {
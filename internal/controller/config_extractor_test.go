@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
 )
 
 const testServicesNs = "ibm-common-services"
@@ -117,6 +118,113 @@ func TestExtractCommonServiceConfigs_RouteHost(t *testing.T) {
 	assert.True(t, found, "extracted configs should contain the routeHost value")
 }
 
+// TestExtractCommonServiceConfigs_PriorityClassName verifies that the
+// priorityClassName is set on the operands, including the EDB Cluster.
+func TestExtractCommonServiceConfigs_PriorityClassName(t *testing.T) {
+	cs := newCS()
+	cs.Spec.PriorityClassName = "cpfs-critical"
+
+	configs, _, err := ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+
+	found := false
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		if service["name"] != "common-service-postgresql" {
+			continue
+		}
+		found = true
+		spec := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+		assert.Equal(t, "cpfs-critical", spec["priorityClassName"])
+	}
+	assert.True(t, found, "extracted configs should contain the priorityClassName of common-service-postgresql")
+
+	// a name that YAML would decode as another type is kept as a string
+	cs.Spec.PriorityClassName = "true"
+	configs, _, err = ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		if service["name"] == "common-service-postgresql" {
+			spec := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+			assert.Equal(t, "true", spec["priorityClassName"])
+		}
+	}
+}
+
+// TestExtractCommonServiceConfigs_Proxy verifies that the proxy environment
+// variables are set on the PostgreSQL clusters.
+func TestExtractCommonServiceConfigs_Proxy(t *testing.T) {
+	cs := newCS()
+	cs.Spec.Proxy = &apiv3.Proxy{HTTPSProxy: "http://proxy.example.com:3128", NoProxy: "example.com"}
+
+	configs, _, err := ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+
+	found := false
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		if service["name"] != "common-service-cnpg" {
+			continue
+		}
+		found = true
+		spec := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+		env := spec["env"].([]interface{})
+		require.Len(t, env, 2)
+		assert.Equal(t, map[string]interface{}{"name": "HTTPS_PROXY", "value": "http://proxy.example.com:3128"}, env[0])
+		assert.Contains(t, env[1].(map[string]interface{})["value"], "example.com,")
+		assert.Contains(t, env[1].(map[string]interface{})["value"], "."+testServicesNs+".svc")
+	}
+	assert.True(t, found, "extracted configs should contain the proxy of common-service-cnpg")
+}
+
+// TestExtractCommonServiceConfigs_TLSSecurityProfile verifies that the TLS
+// security profile is extracted into the PostgreSQL parameters, without the
+// ciphers when the profile only has TLS 1.3 cipher suites, and into the
+// Keycloak options.
+func TestExtractCommonServiceConfigs_TLSSecurityProfile(t *testing.T) {
+	cs := newCS()
+	cs.Spec.TLSSecurityProfile = &apiv3.TLSSecurityProfile{Type: apiv3.TLSProfileModern}
+
+	configs, _, err := ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+
+	found := false
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		if service["name"] != "common-service-cnpg" {
+			continue
+		}
+		data := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})
+		parameters, ok := data["spec"].(map[string]interface{})["postgresql"].(map[string]interface{})["parameters"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		found = true
+		assert.Equal(t, map[string]interface{}{"ssl_min_protocol_version": "TLSv1.3"}, parameters)
+	}
+	assert.True(t, found, "extracted configs should contain the TLS parameters of common-service-cnpg")
+
+	found = false
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		if service["name"] != "keycloak-operator" {
+			continue
+		}
+		data := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})
+		options, ok := data["spec"].(map[string]interface{})["additionalOptions"].([]interface{})
+		if !ok {
+			continue
+		}
+		found = true
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "https-protocols", "value": "TLSv1.3"},
+			map[string]interface{}{"name": "https-cipher-suites", "value": "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384,TLS_CHACHA20_POLY1305_SHA256"},
+		}, options)
+	}
+	assert.True(t, found, "extracted configs should contain the TLS options of keycloak-operator")
+}
+
 // TestExtractCommonServiceConfigs_DefaultAdminUser verifies that a defaultAdminUser
 // value is extracted into the configs slice.
 func TestExtractCommonServiceConfigs_DefaultAdminUser(t *testing.T) {
@@ -262,6 +370,73 @@ func TestExtractCommonServiceConfigs_SizeLarge(t *testing.T) {
 	assert.NotEmpty(t, configs, "large size profile should produce configs")
 }
 
+// TestExtractCommonServiceConfigs_UserDefinedSize verifies that a registered
+// user-defined size profile is applied like a built-in one, and that an
+// unknown size only applies the services from the CS spec.
+func TestExtractCommonServiceConfigs_UserDefinedSize(t *testing.T) {
+	defer size.SetCustomProfiles(nil)
+	require.Empty(t, size.SetCustomProfiles([]size.Profile{
+		{Name: "edge", Priority: 50, Services: "- name: ibm-im-operator\n  spec:\n    authentication:\n      replicas: 1\n"},
+	}))
+
+	cs := newCS()
+	cs.Spec.Size = "edge"
+	configs, _, err := ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, "ibm-im-operator", configs[0].(map[string]interface{})["name"])
+
+	cs.Spec.Size = "xlarge"
+	configs, _, err = ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+	assert.Empty(t, configs, "unknown size profile should not produce size configs")
+}
+
+// TestExtractCommonServiceConfigs_SizeFactors verifies that the size factors
+// scale the size profile, while the values set in the CR services are kept.
+func TestExtractCommonServiceConfigs_SizeFactors(t *testing.T) {
+	defer size.SetCustomProfiles(nil)
+	require.Empty(t, size.SetCustomProfiles([]size.Profile{
+		{Name: "edge", Priority: 50, Services: `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 2
+      resources:
+        requests:
+          cpu: 100m
+          memory: 256Mi
+        limits:
+          cpu: 200m
+          memory: 512Mi
+`},
+	}))
+
+	cs := newCS()
+	cs.Spec.Size = "edge"
+	cs.Spec.SizeFactors = &apiv3.SizeFactors{
+		ScalingFactors: apiv3.ScalingFactors{CPU: "0.5", Target: "requests"},
+		Services: []apiv3.ServiceSizeFactors{
+			{Name: "ibm-im-operator", ScalingFactors: apiv3.ScalingFactors{Memory: "1.5", Replicas: "1.5"}},
+		},
+	}
+	configs, _, err := ExtractCommonServiceConfigs(cs, testServicesNs)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+
+	authentication := configs[0].(map[string]interface{})["spec"].(map[string]interface{})["authentication"].(map[string]interface{})
+	assert.EqualValues(t, 3, authentication["replicas"])
+	resources := authentication["resources"].(map[string]interface{})
+	assert.Equal(t, "50m", resources["requests"].(map[string]interface{})["cpu"])
+	assert.Equal(t, "384Mi", resources["requests"].(map[string]interface{})["memory"])
+	assert.Equal(t, "200m", resources["limits"].(map[string]interface{})["cpu"])
+	assert.Equal(t, "768Mi", resources["limits"].(map[string]interface{})["memory"])
+
+	cs.Spec.SizeFactors.CPU = "-1"
+	_, _, err = ExtractCommonServiceConfigs(cs, testServicesNs)
+	assert.Error(t, err, "a negative factor must be rejected")
+}
+
 // TestExtractCommonServiceConfigs_CustomServices verifies that custom service
 // entries in the CS spec are extracted and the managementStrategy is captured
 // in the serviceControllerMapping.
@@ -494,3 +669,35 @@ func TestExtractPostgreSQLReplicaConfig(t *testing.T) {
 	assert.True(t, hasInitdb, "bootstrap should have initdb field set to nil")
 	assert.Nil(t, initdb, "bootstrap.initdb should be nil to remove base template's initdb")
 }
+
+// TestExtractPostgreSQLReplicaConfig_Action verifies that the action of
+// CSPostgreSQLReplica overrides replica.enabled
+func TestExtractPostgreSQLReplicaConfig_Action(t *testing.T) {
+	enabled := true
+	replicaConfig := &apiv3.CSPostgreSQLReplicaConfig{
+		Replica: pgv1.ReplicaClusterConfiguration{
+			Enabled: &enabled,
+			Source:  "primary-cluster",
+		},
+		ExternalClusters: []pgv1.ExternalCluster{{Name: "primary-cluster"}},
+		Bootstrap: pgv1.BootstrapConfiguration{
+			PgBaseBackup: &pgv1.BootstrapPgBaseBackup{Source: "primary-cluster"},
+		},
+		Action: apiv3.ReplicaActionPromote,
+	}
+
+	replicaSpec := func() map[string]interface{} {
+		result, err := extractPostgreSQLReplicaConfig(replicaConfig)
+		require.NoError(t, err)
+		resources := result.(map[string]interface{})["resources"].([]interface{})
+		spec := resources[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+		assert.NotContains(t, spec, "action", "action should not be rendered into the Cluster")
+		return spec["replica"].(map[string]interface{})
+	}
+
+	assert.Equal(t, false, replicaSpec()["enabled"])
+
+	replicaConfig.Action = apiv3.ReplicaActionDemote
+	replicaConfig.Replica.Enabled = nil
+	assert.Equal(t, true, replicaSpec()["enabled"])
+}
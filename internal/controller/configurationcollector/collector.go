@@ -22,7 +22,6 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
-	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog"
@@ -34,7 +33,7 @@ import (
 
 func Buildconfig(config map[string]string, bs *bootstrap.Bootstrap) map[string]string {
 	builder := configbuilder{data: config, bs: bs}
-	updatedConfig := builder.setDefaultStorageClass().setKeycloakOperatorChannels()
+	updatedConfig := builder.setDefaultStorageClass().setOperatorChannels()
 	return updatedConfig.data
 }
 
@@ -44,17 +43,8 @@ type configbuilder struct {
 }
 
 func (b *configbuilder) setDefaultStorageClass() *configbuilder {
-	allowed, err := b.bs.CanI(context.TODO(), "storage.k8s.io", "storageclasses", "list", "")
-	if err != nil || !allowed {
-		klog.Warningf("Skipping StorageClass population in CPP configmap due to insufficient permissions: %v", err)
-		return b
-	}
-
-	scList := &storagev1.StorageClassList{}
-	if err := b.bs.Reader.List(context.TODO(), scList); err != nil {
-		return b
-	}
-	if len(scList.Items) == 0 {
+	scList, ok := b.bs.ListStorageClasses(context.TODO(), "StorageClass population in CPP configmap")
+	if !ok || len(scList.Items) == 0 {
 		return b
 	}
 
@@ -93,25 +83,29 @@ func (b *configbuilder) setDefaultStorageClass() *configbuilder {
 	return b
 }
 
-// setKeycloakOperatorChannels sets the keycloak operator channels in the config
-func (b *configbuilder) setKeycloakOperatorChannels() *configbuilder {
+// setOperatorChannels sets the channels of the operators with DefaultChannels
+// in the config, the channels resolved from the catalogs are used when they
+// are known
+func (b *configbuilder) setOperatorChannels() *configbuilder {
 	if b.data == nil {
 		b.data = make(map[string]string)
 	}
 
-	keycloakChannels, exists := constant.DefaultChannels["keycloak-operator"]
-	if !exists || len(keycloakChannels) == 0 {
-		keycloakChannels = []string{"stable-v24", "stable-v22"}
-	}
+	for operatorName, defaultChannels := range constant.DefaultChannels {
+		channels := b.bs.ResolvedChannels(operatorName)
+		if len(channels) == 0 {
+			channels = defaultChannels
+		}
 
-	var channelStr strings.Builder
-	for _, channel := range keycloakChannels {
-		channelStr.WriteString("- ")
-		channelStr.WriteString(channel)
-		channelStr.WriteString("\n")
-	}
+		var channelStr strings.Builder
+		for _, channel := range channels {
+			channelStr.WriteString("- ")
+			channelStr.WriteString(channel)
+			channelStr.WriteString("\n")
+		}
 
-	b.data["keycloak-operator"] = channelStr.String()
+		b.data[operatorName] = channelStr.String()
+	}
 
 	return b
 }
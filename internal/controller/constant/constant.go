@@ -70,6 +70,15 @@ const (
 	DefaultRequeueDuration = 20 * time.Second
 	//CsMapsLabel is the label used to label the configmaps are managed by cs operator
 	CsManagedLabel = "operator.ibm.com/managedByCsOperator"
+	// SizeProfileLabel is the label used to label the configmaps defining a user-defined size profile,
+	// they also need the CsManagedLabel to be watched by the operator
+	SizeProfileLabel = "operator.ibm.com/cs-size-profile"
+	// TemplateOverlayLabel is the label used to label the configmaps overlaying the OperandRegistry and
+	// OperandConfig templates, they also need the CsManagedLabel to be watched by the operator
+	TemplateOverlayLabel = "operator.ibm.com/cs-template-overlay"
+	// AutoSizeReevaluateAnnotation is the annotation on the master CommonService CR to request a new evaluation
+	// of the auto size, a new evaluation is done each time its value changes
+	AutoSizeReevaluateAnnotation = "operator.ibm.com/reevaluate-size"
 	//CatalogsourceNs is the namespace of the catalogsource
 	CatalogsourceNs = "openshift-marketplace"
 	//CSCatalogsource is the name of the common service catalogsource
@@ -134,12 +143,48 @@ const (
 	OpreqLabel string = "operator.ibm.com/opreq-control"
 	// CSPGCluster is the name of the common service postgresql cluster
 	CSPGCluster = "common-service-db"
+	// CSPGScheduledBackup is the name of the ScheduledBackup of the common service postgresql cluster
+	CSPGScheduledBackup = "common-service-db-backup"
+	// DefaultBackupStaleAfter is the age of the last successful backup after which it is stale
+	DefaultBackupStaleAfter = 48 * time.Hour
+	// BackupStatusInterval is the interval of the backup status checks
+	BackupStatusInterval = 10 * time.Minute
+	// CSPGRestoreSource is the name of the external cluster the common service postgresql cluster is recovered from
+	CSPGRestoreSource = "common-service-db-restore-source"
+	// MaxReplicaTransitions is the number of promotions and demotions of the common service postgresql cluster kept in the status
+	MaxReplicaTransitions = 10
+	// ReplicaStatusInterval is the interval of the replication status checks
+	ReplicaStatusInterval = 5 * time.Minute
+	// MaxApprovedInstallPlans is the number of InstallPlans approved by the approval policy kept in the status
+	MaxApprovedInstallPlans = 20
+	// OperatorStatusInterval is the interval of the operator Deployment checks without OLM
+	OperatorStatusInterval = 5 * time.Minute
+	// InstallPlanApprovalInterval is the interval of the pending InstallPlan checks of the approval policy
+	InstallPlanApprovalInterval = time.Minute
+	// DBConsumerReplicasAnno is the annotation of the replicas of a Deployment connecting to the common service postgresql cluster before it is scaled down
+	DBConsumerReplicasAnno = "operator.ibm.com/replicas-before-scale-down"
+	// InstallModeOLM installs ODLM and the operators with OLM Subscriptions
+	InstallModeOLM = "olm"
+	// InstallModeNoOLM deploys ODLM and the operators without OLM
+	InstallModeNoOLM = "no-olm"
+	// InstallModeOLMv1 installs ODLM and the operators with OLM v1 ClusterExtensions
+	InstallModeOLMv1 = "olm-v1"
+	// CatalogSourceReady is the gRPC connection state of a READY CatalogSource
+	CatalogSourceReady = "READY"
+	// ClusterExtensionAPIVersion is the API version of the OLM v1 ClusterExtension
+	ClusterExtensionAPIVersion = "olm.operatorframework.io/v1"
+	// ClusterCatalogLabel is the label of the name of an OLM v1 ClusterCatalog
+	ClusterCatalogLabel = "olm.operatorframework.io/metadata.name"
 	// ODLMWatchLabel is the label used to label the Subscription/CR/Configmap managed by ODLM
 	ODLMWatchLabel = "operator.ibm.com/watched-by-odlm"
 	// ODLMReferenceAnno is the annotation used to label the Subscription/CR/Configmap managed by ODLM
 	ODLMReferenceAnno = "operator.ibm.com/referenced-by-odlm-resource"
 )
 
+// PreferredChannelSuffix is the suffix of the keys of the preferred channels of
+// the operators in the ibm-cpp-config ConfigMap
+const PreferredChannelSuffix = ".preferred_channel"
+
 // DefaultChannels defines the default channels available for each operator
 var DefaultChannels = map[string][]string{
 	"keycloak-operator": {"stable-v26", "stable-v24", "stable-v22"},
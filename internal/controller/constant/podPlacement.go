@@ -0,0 +1,106 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+import "fmt"
+
+// Placeholders of the pod placement template, they are replaced by the pod
+// placement of each service, or removed when it is not set
+const (
+	PlaceholderNodeSelector              = "placeholder-node-selector"
+	PlaceholderTolerations               = "placeholder-tolerations"
+	PlaceholderAffinity                  = "placeholder-affinity"
+	PlaceholderNodeAffinity              = "placeholder-node-affinity"
+	PlaceholderPodAffinity               = "placeholder-pod-affinity"
+	PlaceholderPodAntiAffinity           = "placeholder-pod-anti-affinity"
+	PlaceholderTopologySpreadConstraints = "placeholder-topology-spread-constraints"
+)
+
+// imPlacementServices lists the IM operator services
+var imPlacementServices = versionedServices("ibm-im-operator", 18)
+
+// uiPlacementServices lists the common web UI operator services
+var uiPlacementServices = versionedServices("ibm-idp-config-ui-operator", 15)
+
+// PodPlacementTemplate contains the pod placement of the operands
+var PodPlacementTemplate string
+
+const operandPlacementTemplate = `
+- name: %s
+  spec:
+    %s:
+      nodeSelector: placeholder-node-selector
+      tolerations: placeholder-tolerations
+      affinity: placeholder-affinity
+      topologySpreadConstraints: placeholder-topology-spread-constraints`
+
+const keycloakPlacementTemplate = `
+- name: keycloak-operator
+  resources:
+    - apiVersion: k8s.keycloak.org/v2alpha1
+      kind: Keycloak
+      name: cs-keycloak
+      data:
+        spec:
+          unsupported:
+            podTemplate:
+              spec:
+                nodeSelector: placeholder-node-selector
+                tolerations: placeholder-tolerations
+                affinity: placeholder-affinity
+                topologySpreadConstraints: placeholder-topology-spread-constraints`
+
+const clusterPlacementTemplate = `
+- name: %s
+  resources:
+    - apiVersion: %s
+      kind: Cluster
+      name: %s
+      data:
+        spec:
+          affinity:
+            nodeSelector: placeholder-node-selector
+            tolerations: placeholder-tolerations
+            nodeAffinity: placeholder-node-affinity
+            additionalPodAffinity: placeholder-pod-affinity
+            additionalPodAntiAffinity: placeholder-pod-anti-affinity
+          topologySpreadConstraints: placeholder-topology-spread-constraints`
+
+func init() {
+	PodPlacementTemplate = ""
+	for _, service := range imPlacementServices {
+		PodPlacementTemplate += fmt.Sprintf(operandPlacementTemplate, service, "authentication")
+	}
+	for _, service := range uiPlacementServices {
+		PodPlacementTemplate += fmt.Sprintf(operandPlacementTemplate, service, "commonWebUI")
+	}
+	PodPlacementTemplate += fmt.Sprintf(operandPlacementTemplate, "ibm-cert-manager-operator", "certManager")
+	PodPlacementTemplate += keycloakPlacementTemplate
+	PodPlacementTemplate += fmt.Sprintf(clusterPlacementTemplate, "common-service-postgresql", "postgresql.k8s.enterprisedb.io/v1", "common-service-db")
+	PodPlacementTemplate += fmt.Sprintf(clusterPlacementTemplate, "common-service-cnpg", "pg.ibm.com/v1", "common-service-db")
+	PodPlacementTemplate += fmt.Sprintf(clusterPlacementTemplate, "edb-keycloak", "postgresql.k8s.enterprisedb.io/v1", "keycloak-edb-cluster")
+	PodPlacementTemplate += "\n"
+}
+
+// versionedServices returns the service and its versions from v4.0 to v4.<latest>
+func versionedServices(service string, latest int) []string {
+	services := []string{service}
+	for minor := 0; minor <= latest; minor++ {
+		services = append(services, fmt.Sprintf("%s-v4.%d", service, minor))
+	}
+	return services
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PriorityClassTemplate contains the priority class of the operand pods
+var PriorityClassTemplate string
+
+// RenderPriorityClassTemplate returns the PriorityClassTemplate with the
+// priority class name quoted, so that it is always decoded as a string
+func RenderPriorityClassTemplate(name string) string {
+	return strings.ReplaceAll(PriorityClassTemplate, "placeholder", strconv.Quote(name))
+}
+
+const operandPriorityClassTemplate = `
+- name: %s
+  spec:
+    %s:
+      priorityClassName: placeholder`
+
+const keycloakPriorityClassTemplate = `
+- name: keycloak-operator
+  resources:
+    - apiVersion: k8s.keycloak.org/v2alpha1
+      kind: Keycloak
+      name: cs-keycloak
+      data:
+        spec:
+          unsupported:
+            podTemplate:
+              spec:
+                priorityClassName: placeholder`
+
+const clusterPriorityClassTemplate = `
+- name: %s
+  resources:
+    - apiVersion: %s
+      kind: Cluster
+      name: %s
+      data:
+        spec:
+          priorityClassName: placeholder`
+
+func init() {
+	PriorityClassTemplate = ""
+	for _, service := range imPlacementServices {
+		PriorityClassTemplate += fmt.Sprintf(operandPriorityClassTemplate, service, "authentication")
+	}
+	for _, service := range uiPlacementServices {
+		PriorityClassTemplate += fmt.Sprintf(operandPriorityClassTemplate, service, "commonWebUI")
+	}
+	PriorityClassTemplate += fmt.Sprintf(operandPriorityClassTemplate, "ibm-cert-manager-operator", "certManager")
+	PriorityClassTemplate += keycloakPriorityClassTemplate
+	PriorityClassTemplate += fmt.Sprintf(clusterPriorityClassTemplate, "common-service-postgresql", "postgresql.k8s.enterprisedb.io/v1", "common-service-db")
+	PriorityClassTemplate += fmt.Sprintf(clusterPriorityClassTemplate, "common-service-cnpg", "pg.ibm.com/v1", "common-service-db")
+	PriorityClassTemplate += fmt.Sprintf(clusterPriorityClassTemplate, "edb-keycloak", "postgresql.k8s.enterprisedb.io/v1", "keycloak-edb-cluster")
+	PriorityClassTemplate += "\n"
+}
@@ -0,0 +1,47 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+// ProxyTemplate sets the proxy environment variables of the PostgreSQL
+// clusters, the only operands whose CRs support env. The placeholder is
+// replaced by the list of variables in JSON
+const ProxyTemplate = `
+- name: common-service-postgresql
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          env: placeholder
+- name: common-service-cnpg
+  resources:
+    - apiVersion: pg.ibm.com/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          env: placeholder
+- name: edb-keycloak
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: keycloak-edb-cluster
+      data:
+        spec:
+          env: placeholder
+`
@@ -0,0 +1,84 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+import "fmt"
+
+// Placeholders of the service storage template, they are replaced by the
+// storage of each service, or removed when it is not set
+const (
+	PlaceholderStorageClass = "placeholder-storage-class"
+	PlaceholderStorageSize  = "placeholder-storage-size"
+	PlaceholderAccessModes  = "placeholder-access-modes"
+)
+
+// ServiceStorageTemplate contains the volume settings of the services, it has
+// the same services as StorageClassTemplate
+var ServiceStorageTemplate string
+
+const mongoDBStorageTemplate = `
+- name: %s
+  spec:
+    mongoDB:
+      storageClass: placeholder-storage-class`
+
+const healthcheckStorageTemplate = `
+- name: ibm-healthcheck-operator
+  spec:
+    mustgatherService:
+      persistentVolumeClaim:
+        storageClassName: placeholder-storage-class`
+
+const apiCatalogStorageTemplate = `
+- name: ibm-apicatalog
+  spec:
+    apicatalogmanager:
+      externalDB:
+        databaseVolumeClaimTemplate:
+          storageClassName: placeholder-storage-class`
+
+const clusterStorageTemplate = `
+- name: %s
+  resources:
+    - apiVersion: %s
+      kind: Cluster
+      name: %s
+      data:
+        spec:
+          storage:
+            storageClass: placeholder-storage-class
+            size: placeholder-storage-size
+            pvcTemplate:
+              accessModes: placeholder-access-modes
+          walStorage:
+            storageClass: placeholder-storage-class
+            size: placeholder-storage-size
+            pvcTemplate:
+              accessModes: placeholder-access-modes`
+
+func init() {
+	ServiceStorageTemplate = ""
+	for _, service := range []string{"ibm-im-mongodb-operator", "ibm-im-mongodb-operator-v4.0", "ibm-im-mongodb-operator-v4.1", "ibm-im-mongodb-operator-v4.2"} {
+		ServiceStorageTemplate += fmt.Sprintf(mongoDBStorageTemplate, service)
+	}
+	ServiceStorageTemplate += healthcheckStorageTemplate
+	ServiceStorageTemplate += apiCatalogStorageTemplate
+	ServiceStorageTemplate += fmt.Sprintf(clusterStorageTemplate, "edb-keycloak", "postgresql.k8s.enterprisedb.io/v1", "keycloak-edb-cluster")
+	ServiceStorageTemplate += fmt.Sprintf(clusterStorageTemplate, "common-service-postgresql", "postgresql.k8s.enterprisedb.io/v1", "common-service-db")
+	ServiceStorageTemplate += fmt.Sprintf(clusterStorageTemplate, "common-service-cnpg", "pg.ibm.com/v1", "common-service-db")
+	ServiceStorageTemplate += "\n"
+}
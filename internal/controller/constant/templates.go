@@ -0,0 +1,91 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"text/template"
+)
+
+// templates are the OperandRegistry, OperandConfig and ODLM Subscription
+// templates. They are rendered with text/template and the data described in
+// templates/README.md
+//
+//go:embed templates
+var templates embed.FS
+
+// OperandRegistry templates
+var (
+	MongoDBOpReg           = mustReadTemplate("operandregistry/mongodb.yaml")
+	IMOpReg                = mustReadTemplate("operandregistry/im.yaml")
+	IdpConfigUIOpReg       = mustReadTemplate("operandregistry/idp-config-ui.yaml")
+	PlatformUIOpReg        = mustReadTemplate("operandregistry/platform-ui.yaml")
+	KeyCloakOpReg          = mustReadTemplate("operandregistry/keycloak.yaml")
+	CommonServicePGOpReg   = mustReadTemplate("operandregistry/common-service-postgresql.yaml")
+	CommonServiceCNPGOpReg = mustReadTemplate("operandregistry/common-service-cnpg.yaml")
+	// CommonServicePGMigratorOpReg defines the OperandRegistry for the PG migrator
+	// This installs the IBM PG operator v28 as a prerequisite for EDB to IBM PG migration
+	CommonServicePGMigratorOpReg = mustReadTemplate("operandregistry/common-service-pg-migrator.yaml")
+	CSV3OpReg                    = mustReadTemplate("operandregistry/common-service.yaml")
+	CSV4OpReg                    = mustReadTemplate("operandregistry/common-service-v4.yaml")
+	CSV3SaasOpReg                = mustReadTemplate("operandregistry/common-service-saas.yaml")
+)
+
+// OperandConfig templates
+var (
+	MongoDBOpCon           = mustReadTemplate("operandconfig/mongodb.yaml")
+	IMOpCon                = mustReadTemplate("operandconfig/im.yaml")
+	UserMgmtOpCon          = mustReadTemplate("operandconfig/user-mgmt.yaml")
+	IdpConfigUIOpCon       = mustReadTemplate("operandconfig/idp-config-ui.yaml")
+	PlatformUIOpCon        = mustReadTemplate("operandconfig/platform-ui.yaml")
+	KeyCloakOpCon          = mustReadTemplate("operandconfig/keycloak.yaml")
+	CommonServicePGOpCon   = mustReadTemplate("operandconfig/common-service-postgresql.yaml")
+	CommonServiceCNPGOpCon = mustReadTemplate("operandconfig/common-service-cnpg.yaml")
+	// CommonServicePGMigratorOpCon defines the OperandConfig for the PG migrator
+	// This creates the RBAC resources and Job for EDB to IBM PG migration
+	CommonServicePGMigratorOpCon = mustReadTemplate("operandconfig/common-service-pg-migrator.yaml")
+	CSV4OpCon                    = mustReadTemplate("operandconfig/common-service.yaml")
+)
+
+// ODLMSubscription is the Subscription template of ODLM
+var ODLMSubscription = mustReadTemplate("odlm-subscription.yaml")
+
+// mustReadTemplate returns an embedded template, the templates are built into
+// the operator so a missing one is a programming error
+func mustReadTemplate(name string) string {
+	data, err := templates.ReadFile("templates/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("failed to read template %s: %v", name, err))
+	}
+	return string(data)
+}
+
+// RenderTemplate renders a template that is not built into the operator with
+// the same data as the embedded templates
+func RenderTemplate(objectTemplate string, data interface{}) ([]byte, error) {
+	t, err := template.New("overlay").Option("missingkey=error").Parse(objectTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
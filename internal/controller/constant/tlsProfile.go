@@ -0,0 +1,79 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package constant
+
+// Placeholders of the TLS security profile template
+const (
+	PlaceholderTLSMinVersion = "placeholder-tls-min-version"
+	PlaceholderTLSCiphers    = "placeholder-tls-ciphers"
+
+	PlaceholderKeycloakTLSOptions = "placeholder-keycloak-tls-options"
+)
+
+// TLSProfileTemplate sets the minimum TLS version and the ciphers of the
+// PostgreSQL clusters, and the TLS options of Keycloak
+const TLSProfileTemplate = `
+- name: common-service-postgresql
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          postgresql:
+            parameters:
+              ssl_min_protocol_version: placeholder-tls-min-version
+              ssl_ciphers: placeholder-tls-ciphers
+- name: common-service-cnpg
+  resources:
+    - apiVersion: pg.ibm.com/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          postgresql:
+            parameters:
+              ssl_min_protocol_version: placeholder-tls-min-version
+              ssl_ciphers: placeholder-tls-ciphers
+- name: edb-keycloak
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: keycloak-edb-cluster
+      data:
+        spec:
+          postgresql:
+            parameters:
+              ssl_min_protocol_version: placeholder-tls-min-version
+              ssl_ciphers: placeholder-tls-ciphers
+- name: keycloak-operator
+  resources:
+    - apiVersion: k8s.keycloak.org/v2alpha1
+      kind: Keycloak
+      name: cs-keycloak
+      data:
+        spec:
+          additionalOptions: placeholder-keycloak-tls-options
+`
+
+// TLSProfileUnsupportedServices are the services serving TLS without a
+// setting for the minimum TLS version and the ciphers
+var TLSProfileUnsupportedServices = []string{
+	"ibm-im-operator",
+	"ibm-idp-config-ui-operator",
+	"ibm-cert-manager-operator",
+}
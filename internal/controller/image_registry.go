@@ -0,0 +1,41 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// rewriteMergedImages rewrites the images of the merged services with the
+// image registry of the master CommonService CR. It returns an error when an
+// image doesn't match its digest pin.
+func (r *CommonServiceReconciler) rewriteMergedImages(ctx context.Context, services []interface{}) error {
+	masterCR, err := r.getMasterCommonService(ctx)
+	if err != nil {
+		return err
+	}
+	var registry *apiv3.ImageRegistry
+	if masterCR != nil {
+		registry = masterCR.Spec.ImageRegistry
+	}
+	if err := r.Bootstrap.SetImageRegistry(registry); err != nil {
+		return err
+	}
+	return r.Bootstrap.RewriteServiceImages(services)
+}
@@ -0,0 +1,221 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// Names of the NetworkPolicies of the services namespace
+const (
+	networkPolicyFoundationalServices = "common-service-allow-foundational-services"
+	networkPolicyTenant               = "common-service-allow-tenant"
+	networkPolicyIngress              = "common-service-allow-ingress"
+	networkPolicyMonitoring           = "common-service-allow-monitoring"
+	networkPolicyAdditionalPeers      = "common-service-allow-additional-peers"
+)
+
+var networkPolicyNames = []string{
+	networkPolicyFoundationalServices,
+	networkPolicyTenant,
+	networkPolicyIngress,
+	networkPolicyMonitoring,
+	networkPolicyAdditionalPeers,
+}
+
+// namespaceNameLabel is set on every namespace by Kubernetes
+const namespaceNameLabel = "kubernetes.io/metadata.name"
+
+// policyGroupLabel is set by OpenShift on the namespaces of the ingress
+// controller and the monitoring stack
+const policyGroupLabel = "network.openshift.io/policy-group"
+
+// hostNetworkPolicyGroupLabel selects the pods in the host network on
+// OpenShift, like the API server calling the webhooks and the ingress
+// controller in the host network mode
+const hostNetworkPolicyGroupLabel = "policy-group.network.openshift.io/host-network"
+
+// namespacesPeer returns the peer selecting the namespaces by name
+func namespacesPeer(namespaces []string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: namespaceNameLabel, Operator: metav1.LabelSelectorOpIn, Values: namespaces},
+			},
+		},
+	}
+}
+
+// namespaceLabelPeer returns the peer selecting the namespaces by label
+func namespaceLabelPeer(key, value string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{key: value}},
+	}
+}
+
+// desiredNetworkPolicies returns the NetworkPolicies allowing the ingress
+// traffic to all the pods of the services namespace. The ingress and
+// monitoring policies select the OpenShift policy groups, they are only
+// created on OpenShift.
+func desiredNetworkPolicies(config *apiv3.NetworkPolicy, servicesNs, operatorNs string, tenantNs []string, openShift bool) []*networkingv1.NetworkPolicy {
+	if config == nil || !config.Enable {
+		return nil
+	}
+
+	// the foundational services run in the operator and services namespaces
+	foundationalNs := []string{servicesNs}
+	if operatorNs != "" && operatorNs != servicesNs {
+		foundationalNs = append(foundationalNs, operatorNs)
+	}
+
+	seen := map[string]bool{}
+	for _, ns := range foundationalNs {
+		seen[ns] = true
+	}
+	var requestNs []string
+	for _, ns := range tenantNs {
+		if ns == "" || seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		requestNs = append(requestNs, ns)
+	}
+	sort.Strings(requestNs)
+
+	peers := map[string][]networkingv1.NetworkPolicyPeer{
+		networkPolicyFoundationalServices: {namespacesPeer(foundationalNs)},
+	}
+	if openShift {
+		peers[networkPolicyIngress] = []networkingv1.NetworkPolicyPeer{
+			namespaceLabelPeer(policyGroupLabel, "ingress"),
+			namespaceLabelPeer(hostNetworkPolicyGroupLabel, ""),
+		}
+		peers[networkPolicyMonitoring] = []networkingv1.NetworkPolicyPeer{namespaceLabelPeer(policyGroupLabel, "monitoring")}
+	}
+	if len(requestNs) > 0 {
+		peers[networkPolicyTenant] = []networkingv1.NetworkPolicyPeer{namespacesPeer(requestNs)}
+	}
+	if len(config.AdditionalPeers) > 0 {
+		peers[networkPolicyAdditionalPeers] = config.AdditionalPeers
+	}
+
+	var policies []*networkingv1.NetworkPolicy
+	for _, name := range networkPolicyNames {
+		from, ok := peers[name]
+		if !ok {
+			continue
+		}
+		policies = append(policies, &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: servicesNs,
+				Labels: map[string]string{
+					constant.CsManagedLabel: "true",
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				Ingress:     []networkingv1.NetworkPolicyIngressRule{{From: from}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			},
+		})
+	}
+	return policies
+}
+
+// tenantNamespaces returns the namespaces of the tenant from the
+// common-service-maps and namespace-scope ConfigMaps
+func (r *CommonServiceReconciler) tenantNamespaces() ([]string, error) {
+	namespaces := util.GetRequestNs(r.Reader)
+	nssNamespaces, err := util.GetNssCmNs(r.Reader, r.Bootstrap.CSData.CPFSNs)
+	if err != nil {
+		return nil, err
+	}
+	return append(namespaces, nssNamespaces...), nil
+}
+
+// reconcileNetworkPolicies creates or updates the NetworkPolicies of the
+// services namespace, and deletes them when they are disabled
+func (r *CommonServiceReconciler) reconcileNetworkPolicies(ctx context.Context, config *apiv3.NetworkPolicy) error {
+	var tenantNs []string
+	openShift := false
+	if config != nil && config.Enable {
+		var err error
+		if tenantNs, err = r.tenantNamespaces(); err != nil {
+			return err
+		}
+		openShift = r.Bootstrap.IsOpenShiftCluster()
+	}
+
+	namespace := r.Bootstrap.CSData.ServicesNs
+	desired := map[string]bool{}
+	for _, policy := range desiredNetworkPolicies(config, namespace, r.Bootstrap.CSData.CPFSNs, tenantNs, openShift) {
+		desired[policy.Name] = true
+		current := &networkingv1.NetworkPolicy{}
+		if err := r.Reader.Get(ctx, types.NamespacedName{Name: policy.Name, Namespace: namespace}, current); err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			klog.Infof("Creating NetworkPolicy %s/%s", namespace, policy.Name)
+			if err := r.Client.Create(ctx, policy); err != nil {
+				return err
+			}
+			continue
+		}
+		if current.Labels[constant.CsManagedLabel] != "true" {
+			klog.Warningf("NetworkPolicy %s/%s is not managed by the operator, skip updating it", namespace, policy.Name)
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Spec, policy.Spec) {
+			continue
+		}
+		klog.Infof("Updating NetworkPolicy %s/%s", namespace, policy.Name)
+		current.Spec = policy.Spec
+		if err := r.Client.Update(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	policies := &networkingv1.NetworkPolicyList{}
+	if err := r.Reader.List(ctx, policies, client.InNamespace(namespace), client.MatchingLabels{constant.CsManagedLabel: "true"}); err != nil {
+		return err
+	}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if desired[policy.Name] || !util.Contains(networkPolicyNames, policy.Name) {
+			continue
+		}
+		klog.Infof("Deleting NetworkPolicy %s/%s", namespace, policy.Name)
+		if err := r.Client.Delete(ctx, policy); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
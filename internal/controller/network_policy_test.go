@@ -0,0 +1,110 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/bootstrap"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+func TestDesiredNetworkPolicies(t *testing.T) {
+	assert.Empty(t, desiredNetworkPolicies(nil, "cs-services", "cs-operator", nil, true))
+	assert.Empty(t, desiredNetworkPolicies(&apiv3.NetworkPolicy{}, "cs-services", "cs-operator", nil, true))
+
+	policies := desiredNetworkPolicies(&apiv3.NetworkPolicy{Enable: true}, "cs-services", "cs-operator", []string{"tenant-b", "cs-operator", "tenant-a", "tenant-b"}, true)
+	var names []string
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+		assert.Equal(t, "cs-services", policy.Namespace)
+		assert.Equal(t, "true", policy.Labels[constant.CsManagedLabel])
+		assert.Empty(t, policy.Spec.PodSelector.MatchLabels)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+	}
+	assert.Equal(t, []string{networkPolicyFoundationalServices, networkPolicyTenant, networkPolicyIngress, networkPolicyMonitoring}, names)
+	assert.Equal(t, []string{"cs-services", "cs-operator"}, policies[0].Spec.Ingress[0].From[0].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, []string{"tenant-a", "tenant-b"}, policies[1].Spec.Ingress[0].From[0].NamespaceSelector.MatchExpressions[0].Values)
+
+	peer := networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/16"}}
+	policies = desiredNetworkPolicies(&apiv3.NetworkPolicy{Enable: true, AdditionalPeers: []networkingv1.NetworkPolicyPeer{peer}}, "cs-services", "cs-services", nil, true)
+	require.Len(t, policies, 4)
+	assert.Equal(t, []string{"cs-services"}, policies[0].Spec.Ingress[0].From[0].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, networkPolicyAdditionalPeers, policies[3].Name)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{peer}, policies[3].Spec.Ingress[0].From)
+
+	// the OpenShift policy groups are not selected on other clusters
+	policies = desiredNetworkPolicies(&apiv3.NetworkPolicy{Enable: true, AdditionalPeers: []networkingv1.NetworkPolicyPeer{peer}}, "cs-services", "cs-services", nil, false)
+	require.Len(t, policies, 2)
+	assert.Equal(t, networkPolicyFoundationalServices, policies[0].Name)
+	assert.Equal(t, networkPolicyAdditionalPeers, policies[1].Name)
+}
+
+func TestReconcileNetworkPolicies(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+
+	nssConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.NamespaceScopeConfigmapName, Namespace: "cs-operator"},
+		Data:       map[string]string{"namespaces": "cs-operator,cs-services,tenant-a"},
+	}
+	unmanaged := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "cs-services"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nssConfigMap, unmanaged).Build()
+	r := &CommonServiceReconciler{Bootstrap: &bootstrap.Bootstrap{
+		Client: fakeClient,
+		Reader: fakeClient,
+		Config: &rest.Config{Host: "http://127.0.0.1:0"},
+		CSData: apiv3.CSData{CPFSNs: "cs-operator", OperatorNs: "cs-operator", ServicesNs: "cs-services"},
+	}}
+
+	ctx := context.Background()
+	config := &apiv3.NetworkPolicy{Enable: true}
+	require.NoError(t, r.reconcileNetworkPolicies(ctx, config))
+
+	policies := &networkingv1.NetworkPolicyList{}
+	require.NoError(t, fakeClient.List(ctx, policies, client.InNamespace("cs-services")))
+	var names []string
+	for _, policy := range policies.Items {
+		names = append(names, policy.Name)
+		if policy.Name == networkPolicyTenant {
+			assert.Equal(t, []string{"tenant-a"}, policy.Spec.Ingress[0].From[0].NamespaceSelector.MatchExpressions[0].Values)
+		}
+	}
+	assert.ElementsMatch(t, []string{"deny-all", networkPolicyFoundationalServices, networkPolicyTenant}, names)
+
+	// disabling the policies removes the managed ones only
+	config.Enable = false
+	require.NoError(t, r.reconcileNetworkPolicies(ctx, config))
+	require.NoError(t, fakeClient.List(ctx, policies, client.InNamespace("cs-services")))
+	require.Len(t, policies.Items, 1)
+	assert.Equal(t, "deny-all", policies.Items[0].Name)
+}
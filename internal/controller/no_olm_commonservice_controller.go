@@ -112,6 +112,11 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 	r.Bootstrap.CSData.CatalogSourceNs = ""
 	r.Bootstrap.CSData.ImagePullSecret = instance.GetImagePullSecret()
 
+	// Choose the profile of the auto size before the size configurations are resolved
+	if err := r.Bootstrap.EvaluateAutoSize(ctx, instance); err != nil {
+		klog.Warningf("Failed to evaluate auto size: %v", err)
+	}
+
 	if statusErr = r.Client.Status().Patch(ctx, instance, client.MergeFrom(originalInstance)); statusErr != nil {
 		return ctrl.Result{}, fmt.Errorf("error while patching CommonService.Status: %v", statusErr)
 	}
@@ -189,7 +194,9 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 	if existOpreg && existOpcon {
 		klog.Info("Installing/Updating OperandRegistry")
 		userManagedOption := bootstrap.WithUserManagedOverridesFromConfigs(instance.Spec.OperatorConfigs)
-		if err := r.Bootstrap.InstallOrUpdateOpreg(ctx, "", userManagedOption); err != nil {
+		placementOption := bootstrap.WithPodPlacement(instance.Spec.Placement)
+		disabledOption := bootstrap.WithDisabledServices(instance.Spec.Services)
+		if err := r.Bootstrap.InstallOrUpdateOpreg(ctx, "", userManagedOption, placementOption, disabledOption); err != nil {
 			klog.Errorf("Fail to Installing/Updating OperandConfig: %v", err)
 			return ctrl.Result{}, err
 		}
@@ -203,6 +210,15 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 		klog.Error("ODLM CRD not ready, waiting for it to be ready")
 	}
 
+	// Create the NetworkPolicies of the services namespace when they are enabled
+	if statusErr = r.reconcileNetworkPolicies(ctx, instance.Spec.NetworkPolicy); statusErr != nil {
+		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
+			klog.Error(err)
+		}
+		klog.Errorf("Failed to reconcile NetworkPolicies: %v", statusErr)
+		return ctrl.Result{}, statusErr
+	}
+
 	var isEqual bool
 
 	if isEqual, statusErr = r.updateOperatorConfig(ctx, instance.Spec.OperatorConfigs); statusErr != nil {
@@ -243,8 +259,16 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 		return ctrl.Result{}, statusErr
 	}
 
+	if optStatusReady, optStatusErr := r.Bootstrap.CheckSubOperatorStatus(instance); optStatusErr != nil {
+		klog.Errorf("Failed to check the status of the operators in the OperandRegistry: %v", optStatusErr)
+		return ctrl.Result{}, optStatusErr
+	} else if !optStatusReady {
+		klog.Infof("Operators in the OperandRegistry are not deployed yet, skip operator status update")
+	}
+
 	klog.Infof("Finished reconciling CommonService: %s/%s", instance.Namespace, instance.Name)
-	return ctrl.Result{}, nil
+	// the operator Deployments are not watched, check their status again
+	return ctrl.Result{RequeueAfter: constant.OperatorStatusInterval}, nil
 }
 
 // ReconcileGeneralCR is for setting the OperandConfig
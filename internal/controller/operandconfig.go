@@ -33,6 +33,7 @@ import (
 	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
 )
 
 var (
@@ -271,16 +272,20 @@ func mergeResourceArrays(baseResources, csResources []interface{}, opconNs strin
 				mergedResource := mergeCRsIntoOperandConfigWithDefaultRules(baseMap, csMap, false)
 
 				// Post-merge cleanup: when a CNPG Cluster resource carries replica config
-				// (i.e. bootstrap.pg_basebackup is present), remove bootstrap.initdb from
-				// the merged result. The merge framework cannot express deletion via nil, so
-				// we handle it here explicitly.
-				if csKind == "Cluster" && csApiVersion == "pg.ibm.com/v1" {
+				// (i.e. bootstrap.pg_basebackup is present) or restore config (i.e.
+				// bootstrap.recovery is present), remove bootstrap.initdb from the merged
+				// result. The merge framework cannot express deletion via nil, so we handle
+				// it here explicitly.
+				if csKind == "Cluster" && (csApiVersion == "pg.ibm.com/v1" || csApiVersion == "postgresql.k8s.enterprisedb.io/v1") {
 					if dataMap, ok := toStringMap(mergedResource["data"]); ok {
 						if specMap, ok := toStringMap(dataMap["spec"]); ok {
 							if bootstrap, ok := toStringMap(specMap["bootstrap"]); ok {
 								if _, hasPgBaseBackup := bootstrap["pg_basebackup"]; hasPgBaseBackup {
 									delete(bootstrap, "initdb")
 									klog.Infof("Removed bootstrap.initdb from Cluster %s/%s (replica mode: pg_basebackup present)", csNamespace, csName)
+								} else if _, hasRecovery := bootstrap["recovery"]; hasRecovery {
+									delete(bootstrap, "initdb")
+									klog.Infof("Removed bootstrap.initdb from Cluster %s/%s (restore mode: recovery present)", csNamespace, csName)
 								}
 							}
 						}
@@ -612,6 +617,25 @@ func (r *CommonServiceReconciler) updateOperandConfig(ctx context.Context, newCo
 	// If a resource is removed from CS CR, it won't be in the final result
 	mergedServices := r.mergeServicesWithBase(baseTemplateServices, csDesiredServices, ruleSlice)
 
+	// Validate the merged sizing, LARGEST_VALUE may take the requests and the
+	// limits of a container from different CommonService CRs
+	if err := r.validateMergedSizing(ctx, mergedServices); err != nil {
+		klog.Errorf("Failed to validate the sizing of OperandConfig: %v", err)
+		return true, err
+	}
+
+	// Keep the PodDisruptionBudgets in line with the merged replica counts
+	if err := r.reconcilePodDisruptionBudgets(ctx, mergedServices); err != nil {
+		klog.Errorf("Failed to reconcile PodDisruptionBudgets: %v", err)
+		return true, err
+	}
+
+	// Rewrite the images for the mirrored registries
+	if err := r.rewriteMergedImages(ctx, mergedServices); err != nil {
+		klog.Errorf("Failed to rewrite the images of OperandConfig: %v", err)
+		return true, err
+	}
+
 	// 6. Calculate hashes for comparison
 	mergedHash, err := util.CalculateResourceHash(map[string]interface{}{"services": mergedServices})
 	if err != nil {
@@ -661,6 +685,9 @@ func (r *CommonServiceReconciler) getBaseTemplateServices() ([]interface{}, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to concatenate base configs: %v", err)
 	}
+	if concatenatedCon, err = r.Bootstrap.ApplyOperandConfigOverlays(concatenatedCon); err != nil {
+		return nil, fmt.Errorf("failed to apply template overlays to base configs: %v", err)
+	}
 
 	// Use common utility function to parse and extract services
 	services, err := util.ParseOperandConfigServices(concatenatedCon)
@@ -849,14 +876,6 @@ func (r *CommonServiceReconciler) getLargestSizeFromCRs(ctx context.Context) (st
 		return "", err
 	}
 
-	sizePriority := map[string]int{
-		"starterset": 0,
-		"starter":    0,
-		"small":      1,
-		"medium":     2,
-		"large":      3,
-	}
-
 	largestSize := ""
 	largestPriority := -1
 
@@ -866,7 +885,7 @@ func (r *CommonServiceReconciler) getLargestSizeFromCRs(ctx context.Context) (st
 		}
 
 		csSize := cs.Spec.Size
-		if priority, ok := sizePriority[csSize]; ok {
+		if priority, ok := size.Priority(csSize); ok {
 			klog.Infof("CommonService CR %s/%s has size: %s (priority: %d)", cs.Namespace, cs.Name, csSize, priority)
 			if priority > largestPriority {
 				largestPriority = priority
@@ -874,13 +893,13 @@ func (r *CommonServiceReconciler) getLargestSizeFromCRs(ctx context.Context) (st
 				klog.Infof("New largest size found: %s (priority: %d)", largestSize, largestPriority)
 			}
 		} else if csSize != "" {
-			klog.Infof("CommonService CR %s/%s has custom size configuration (not a predefined size)", cs.Namespace, cs.Name)
+			klog.Infof("CommonService CR %s/%s has custom size configuration (not a known size profile)", cs.Namespace, cs.Name)
 		}
 	}
 
 	if largestSize == "" {
-		klog.Info("No predefined size found in any CommonService CR, will use starterset")
-		return "starterset", nil
+		klog.Info("No known size profile found in any CommonService CR, will use starterset")
+		return size.StarterSetProfile, nil
 	}
 
 	klog.Infof("FINAL DECISION: Largest size across all CommonService CRs is: %s (priority: %d)", largestSize, largestPriority)
@@ -1251,6 +1270,51 @@ func (r *CommonServiceReconciler) buildDesiredStateFromAllCRs(ctx context.Contex
 			klog.Infof("Collected hugepages from CR %s/%s", cs.Namespace, cs.Name)
 		}
 
+		// Collect pod placement (first non-nil wins)
+		if mergedFeatureCS.Spec.Placement == nil && cs.Spec.Placement != nil {
+			mergedFeatureCS.Spec.Placement = cs.Spec.Placement.DeepCopy()
+			klog.Infof("Collected pod placement from CR %s/%s", cs.Namespace, cs.Name)
+		}
+
+		// Collect priorityClassName (first non-empty wins)
+		if mergedFeatureCS.Spec.PriorityClassName == "" && cs.Spec.PriorityClassName != "" {
+			mergedFeatureCS.Spec.PriorityClassName = cs.Spec.PriorityClassName
+			klog.Infof("Collected priorityClassName=%s from CR %s/%s", cs.Spec.PriorityClassName, cs.Namespace, cs.Name)
+		}
+
+		// Collect proxy (first non-nil wins)
+		if mergedFeatureCS.Spec.Proxy == nil && cs.Spec.Proxy != nil {
+			mergedFeatureCS.Spec.Proxy = cs.Spec.Proxy.DeepCopy()
+			klog.Infof("Collected proxy from CR %s/%s", cs.Namespace, cs.Name)
+		}
+
+		// Collect TLS security profile (first non-nil wins)
+		if mergedFeatureCS.Spec.TLSSecurityProfile == nil && cs.Spec.TLSSecurityProfile != nil {
+			mergedFeatureCS.Spec.TLSSecurityProfile = cs.Spec.TLSSecurityProfile.DeepCopy()
+			klog.Infof("Collected TLS security profile from CR %s/%s", cs.Namespace, cs.Name)
+		}
+
+		// Collect per-service storage (first CR setting a service wins)
+		for _, storage := range cs.Spec.Storage {
+			if storageForService(mergedFeatureCS.Spec.Storage, storage.Name) == nil {
+				mergedFeatureCS.Spec.Storage = append(mergedFeatureCS.Spec.Storage, *storage.DeepCopy())
+				klog.Infof("Collected storage of service %s from CR %s/%s", storage.Name, cs.Namespace, cs.Name)
+			}
+		}
+
+		// Collect backup (only from the CommonService CR in the operator namespace)
+		if cs.Name == constant.MasterCR && cs.Namespace == r.CSData.OperatorNs && cs.Spec.Backup != nil {
+			mergedFeatureCS.Spec.Backup = cs.Spec.Backup.DeepCopy()
+			klog.Infof("Collected backup from CR %s/%s", cs.Namespace, cs.Name)
+		}
+
+		// Collect restore and its progress (only from the CommonService CR in the operator namespace)
+		if cs.Name == constant.MasterCR && cs.Namespace == r.CSData.OperatorNs && cs.Spec.Restore != nil {
+			mergedFeatureCS.Spec.Restore = cs.Spec.Restore.DeepCopy()
+			mergedFeatureCS.Status.Restore = cs.Status.Restore.DeepCopy()
+			klog.Infof("Collected restore %s from CR %s/%s", cs.Spec.Restore.Name, cs.Namespace, cs.Name)
+		}
+
 		// Collect APICatalog storageClass (first non-empty wins)
 		if cs.Spec.Features != nil && cs.Spec.Features.APICatalog != nil && cs.Spec.Features.APICatalog.StorageClass != "" {
 			if mergedFeatureCS.Spec.Features == nil {
@@ -1290,6 +1354,18 @@ func (r *CommonServiceReconciler) buildDesiredStateFromAllCRs(ctx context.Contex
 		mergedFeatureCS.Spec.AutoScaleConfig = new(bool) // false
 	}
 
+	// Detect the cluster proxy when no CR sets it
+	mergedFeatureCS.Spec.Proxy = r.Bootstrap.ResolveProxy(ctx, mergedFeatureCS.Spec.Proxy)
+
+	// Report the storage classes of the services that don't exist
+	r.Bootstrap.CheckServiceStorageClasses(ctx, mergedFeatureCS.Spec.Storage)
+
+	// Report the priority class of the operands when it doesn't exist
+	r.Bootstrap.CheckPriorityClass(ctx, mergedFeatureCS.Spec.PriorityClassName)
+
+	// Inherit the TLS security profile of the cluster when it is requested
+	mergedFeatureCS.Spec.TLSSecurityProfile = r.Bootstrap.ResolveTLSSecurityProfile(ctx, mergedFeatureCS.Spec.TLSSecurityProfile)
+
 	// PASS 2: Apply collected global features to ALL services (base layer)
 	klog.Info("PASS 2: Applying collected global features to all services as base layer")
 	var aggregatedConfigs []interface{}
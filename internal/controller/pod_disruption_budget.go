@@ -0,0 +1,205 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// podDisruptionBudgetTarget is a component of the size profiles and the pods
+// its replica count applies to
+type podDisruptionBudgetTarget struct {
+	// service is the unversioned service name in the OperandConfig
+	service string
+	// component is the key under the service spec, or the name of the
+	// resource for the services with resources
+	component string
+	name      string
+	selector  map[string]string
+}
+
+// The EDB Cluster resources are left out as the EDB operator manages the
+// PodDisruptionBudgets of the clusters
+var podDisruptionBudgetTargets = []podDisruptionBudgetTarget{
+	{service: "ibm-im-operator", component: "authentication", name: "platform-auth-service", selector: map[string]string{"app": "platform-auth-service"}},
+	{service: "ibm-im-operator", component: "authentication", name: "platform-identity-management", selector: map[string]string{"app": "platform-identity-management"}},
+	{service: "ibm-im-operator", component: "authentication", name: "platform-identity-provider", selector: map[string]string{"app": "platform-identity-provider"}},
+	{service: "ibm-idp-config-ui-operator", component: "commonWebUI", name: "common-web-ui", selector: map[string]string{"k8s-app": "common-web-ui"}},
+	{service: "keycloak-operator", component: "cs-keycloak", name: "cs-keycloak", selector: map[string]string{"app": "keycloak", "app.kubernetes.io/instance": "cs-keycloak"}},
+}
+
+// componentReplicas returns the largest replica count of a component across
+// the versions of the service, 0 when it is not set
+func componentReplicas(services []interface{}, service, component string) int64 {
+	var replicas int64
+	for _, item := range services {
+		config, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := config["name"].(string)
+		if name != service && !strings.HasPrefix(name, service+"-v") {
+			continue
+		}
+
+		var componentConfig map[string]interface{}
+		if spec, ok := config["spec"].(map[string]interface{}); ok {
+			componentConfig, _ = spec[component].(map[string]interface{})
+		}
+		if resources, ok := config["resources"].([]interface{}); ok {
+			for _, res := range resources {
+				resMap, ok := res.(map[string]interface{})
+				if !ok || resMap["name"] != component {
+					continue
+				}
+				data, _ := resMap["data"].(map[string]interface{})
+				componentConfig, _ = data["spec"].(map[string]interface{})
+			}
+		}
+
+		for key := range replicaKeys {
+			var value int64
+			switch count := componentConfig[key].(type) {
+			case int:
+				value = int64(count)
+			case int64:
+				value = count
+			case float64:
+				value = int64(count)
+			}
+			if value > replicas {
+				replicas = value
+			}
+		}
+	}
+	return replicas
+}
+
+// desiredPodDisruptionBudgets returns the PodDisruptionBudgets of the
+// components running more than one replica
+func desiredPodDisruptionBudgets(services []interface{}, config *apiv3.PodDisruptionBudget, namespace string) []*policyv1.PodDisruptionBudget {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	maxUnavailable := intstr.FromInt(1)
+	if config.MaxUnavailable != nil {
+		maxUnavailable = *config.MaxUnavailable
+	}
+
+	var budgets []*policyv1.PodDisruptionBudget
+	for _, target := range podDisruptionBudgetTargets {
+		if componentReplicas(services, target.service, target.component) <= 1 {
+			continue
+		}
+		maxUnavailable := maxUnavailable
+		budgets = append(budgets, &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      target.name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					constant.CsManagedLabel: "true",
+				},
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+				Selector:       &metav1.LabelSelector{MatchLabels: target.selector},
+			},
+		})
+	}
+	return budgets
+}
+
+// reconcilePodDisruptionBudgets creates or updates the PodDisruptionBudgets of
+// the components running more than one replica in the merged services, and
+// deletes the ones that are no longer needed
+func (r *CommonServiceReconciler) reconcilePodDisruptionBudgets(ctx context.Context, services []interface{}) error {
+	masterCR, err := r.getMasterCommonService(ctx)
+	if err != nil {
+		return err
+	}
+	var config *apiv3.PodDisruptionBudget
+	if masterCR != nil {
+		config = masterCR.Spec.PodDisruptionBudget
+	}
+
+	namespace := r.Bootstrap.CSData.ServicesNs
+	desired := map[string]bool{}
+	for _, budget := range desiredPodDisruptionBudgets(services, config, namespace) {
+		desired[budget.Name] = true
+		current := &policyv1.PodDisruptionBudget{}
+		if err := r.Reader.Get(ctx, types.NamespacedName{Name: budget.Name, Namespace: namespace}, current); err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			klog.Infof("Creating PodDisruptionBudget %s/%s", namespace, budget.Name)
+			if err := r.Client.Create(ctx, budget); err != nil {
+				return err
+			}
+			continue
+		}
+		if current.Labels[constant.CsManagedLabel] != "true" {
+			klog.Warningf("PodDisruptionBudget %s/%s is not managed by the operator, skip updating it", namespace, budget.Name)
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Spec, budget.Spec) {
+			continue
+		}
+		klog.Infof("Updating PodDisruptionBudget %s/%s", namespace, budget.Name)
+		current.Spec = budget.Spec
+		if err := r.Client.Update(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	budgets := &policyv1.PodDisruptionBudgetList{}
+	if err := r.Reader.List(ctx, budgets, client.InNamespace(namespace), client.MatchingLabels{constant.CsManagedLabel: "true"}); err != nil {
+		return err
+	}
+	for i := range budgets.Items {
+		budget := &budgets.Items[i]
+		if desired[budget.Name] || !isPodDisruptionBudgetTarget(budget.Name) {
+			continue
+		}
+		klog.Infof("Deleting PodDisruptionBudget %s/%s", namespace, budget.Name)
+		if err := r.Client.Delete(ctx, budget); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func isPodDisruptionBudgetTarget(name string) bool {
+	for _, target := range podDisruptionBudgetTargets {
+		if target.name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/bootstrap"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+const podDisruptionBudgetServices = `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 1
+- name: ibm-im-operator-v4.5
+  spec:
+    authentication:
+      replicas: 3
+- name: ibm-idp-config-ui-operator
+  spec:
+    commonWebUI:
+      replicas: 1
+- name: keycloak-operator
+  resources:
+  - apiVersion: k8s.keycloak.org/v2alpha1
+    kind: Keycloak
+    name: cs-keycloak
+    data:
+      spec:
+        instances: 2
+`
+
+func TestComponentReplicas(t *testing.T) {
+	services, err := convertStringToSlice(podDisruptionBudgetServices)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), componentReplicas(services, "ibm-im-operator", "authentication"))
+	assert.Equal(t, int64(1), componentReplicas(services, "ibm-idp-config-ui-operator", "commonWebUI"))
+	assert.Equal(t, int64(2), componentReplicas(services, "keycloak-operator", "cs-keycloak"))
+	assert.Equal(t, int64(0), componentReplicas(services, "ibm-cert-manager-operator", "certManager"))
+}
+
+func TestDesiredPodDisruptionBudgets(t *testing.T) {
+	services, err := convertStringToSlice(podDisruptionBudgetServices)
+	require.NoError(t, err)
+
+	assert.Empty(t, desiredPodDisruptionBudgets(services, nil, "ibm-common-services"))
+	assert.Empty(t, desiredPodDisruptionBudgets(services, &apiv3.PodDisruptionBudget{}, "ibm-common-services"))
+
+	maxUnavailable := intstr.FromString("50%")
+	budgets := desiredPodDisruptionBudgets(services, &apiv3.PodDisruptionBudget{Enable: true, MaxUnavailable: &maxUnavailable}, "ibm-common-services")
+	var names []string
+	for _, budget := range budgets {
+		names = append(names, budget.Name)
+		assert.Equal(t, "ibm-common-services", budget.Namespace)
+		assert.Equal(t, "true", budget.Labels[constant.CsManagedLabel])
+		assert.Equal(t, maxUnavailable, *budget.Spec.MaxUnavailable)
+	}
+	assert.Equal(t, []string{"platform-auth-service", "platform-identity-management", "platform-identity-provider", "cs-keycloak"}, names)
+}
+
+func TestReconcilePodDisruptionBudgets(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiv3.AddToScheme(scheme))
+	require.NoError(t, policyv1.AddToScheme(scheme))
+
+	masterCR := &apiv3.CommonService{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: "cs-operator"},
+		Spec:       apiv3.CommonServiceSpec{PodDisruptionBudget: &apiv3.PodDisruptionBudget{Enable: true}},
+	}
+	stale := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "common-web-ui", Namespace: "cs-services", Labels: map[string]string{constant.CsManagedLabel: "true"}},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(masterCR, stale).Build()
+	r := &CommonServiceReconciler{Bootstrap: &bootstrap.Bootstrap{
+		Client: fakeClient,
+		Reader: fakeClient,
+		CSData: apiv3.CSData{OperatorNs: "cs-operator", ServicesNs: "cs-services"},
+	}}
+
+	services, err := convertStringToSlice(podDisruptionBudgetServices)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, services))
+
+	budgets := &policyv1.PodDisruptionBudgetList{}
+	require.NoError(t, fakeClient.List(ctx, budgets, client.InNamespace("cs-services")))
+	var names []string
+	for _, budget := range budgets.Items {
+		names = append(names, budget.Name)
+		assert.Equal(t, intstr.FromInt(1), *budget.Spec.MaxUnavailable)
+	}
+	assert.ElementsMatch(t, []string{"platform-auth-service", "platform-identity-management", "platform-identity-provider", "cs-keycloak"}, names)
+
+	// scaling keycloak down to one instance removes its budget
+	services[3].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["instances"] = 1
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, services))
+	require.NoError(t, fakeClient.List(ctx, budgets, client.InNamespace("cs-services")))
+	assert.Len(t, budgets.Items, 3)
+
+	// disabling the budgets removes them all
+	masterCR.Spec.PodDisruptionBudget.Enable = false
+	require.NoError(t, fakeClient.Update(ctx, masterCR))
+	require.NoError(t, r.reconcilePodDisruptionBudgets(ctx, services))
+	require.NoError(t, fakeClient.List(ctx, budgets, client.InNamespace("cs-services")))
+	assert.Empty(t, budgets.Items)
+}
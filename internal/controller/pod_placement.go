@@ -0,0 +1,143 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// renderPodPlacement renders the pod placement template for each service. The
+// services without any placement are left out.
+func renderPodPlacement(placement *apiv3.Placement) ([]interface{}, error) {
+	if placement == nil {
+		return nil, nil
+	}
+	services, err := convertStringToSlice(constant.PodPlacementTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []interface{}
+	for _, item := range services {
+		service, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := service["name"].(string)
+		values, err := placementValues(placement.ForService(name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the pod placement of service %s: %v", name, err)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		for _, key := range []string{"spec", "resources"} {
+			if config, ok := service[key]; ok && replacePlaceholders(config, values) {
+				delete(service, key)
+			}
+		}
+		if _, ok := service["spec"]; !ok {
+			if _, ok := service["resources"]; !ok {
+				continue
+			}
+		}
+		configs = append(configs, service)
+	}
+	return configs, nil
+}
+
+// placementValues maps the placeholders of the template to the values of the
+// pod placement, the placeholders of the fields not set are left out
+func placementValues(placement apiv3.PodPlacement) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if placement.NodeSelector != nil {
+		fields[constant.PlaceholderNodeSelector] = placement.NodeSelector
+	}
+	if placement.Tolerations != nil {
+		fields[constant.PlaceholderTolerations] = placement.Tolerations
+	}
+	if placement.Affinity != nil {
+		fields[constant.PlaceholderAffinity] = placement.Affinity
+		if placement.Affinity.NodeAffinity != nil {
+			fields[constant.PlaceholderNodeAffinity] = placement.Affinity.NodeAffinity
+		}
+		if placement.Affinity.PodAffinity != nil {
+			fields[constant.PlaceholderPodAffinity] = placement.Affinity.PodAffinity
+		}
+		if placement.Affinity.PodAntiAffinity != nil {
+			fields[constant.PlaceholderPodAntiAffinity] = placement.Affinity.PodAntiAffinity
+		}
+	}
+	if placement.TopologySpreadConstraints != nil {
+		fields[constant.PlaceholderTopologySpreadConstraints] = placement.TopologySpreadConstraints
+	}
+
+	values := map[string]interface{}{}
+	for placeholder, field := range fields {
+		// convert the typed value to the unstructured form of the OperandConfig
+		fieldBytes, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if err := json.Unmarshal(fieldBytes, &value); err != nil {
+			return nil, err
+		}
+		values[placeholder] = value
+	}
+	return values, nil
+}
+
+// replacePlaceholders replaces the placeholders in place and removes the ones
+// without a value. It returns true when nothing is left in the config.
+func replacePlaceholders(config interface{}, values map[string]interface{}) bool {
+	switch config := config.(type) {
+	case map[string]interface{}:
+		for key, value := range config {
+			if placeholder, ok := value.(string); ok {
+				if replacement, ok := values[placeholder]; ok {
+					config[key] = replacement
+				} else {
+					delete(config, key)
+				}
+				continue
+			}
+			if replacePlaceholders(value, values) {
+				delete(config, key)
+			}
+		}
+		return len(config) == 0
+	case []interface{}:
+		// the resources of a service, a resource without data is left out
+		empty := true
+		for _, value := range config {
+			resource, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if data, ok := resource["data"]; ok && !replacePlaceholders(data, values) {
+				empty = false
+			}
+		}
+		return empty
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func findPlacementService(configs []interface{}, name string) map[string]interface{} {
+	for _, config := range configs {
+		if service := config.(map[string]interface{}); service["name"] == name {
+			return service
+		}
+	}
+	return nil
+}
+
+func TestRenderPodPlacement_Global(t *testing.T) {
+	configs, err := renderPodPlacement(&apiv3.Placement{
+		PodPlacement: apiv3.PodPlacement{
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+		},
+	})
+	require.NoError(t, err)
+
+	im := findPlacementService(configs, "ibm-im-operator-v4.5")
+	require.NotNil(t, im)
+	authentication := im["spec"].(map[string]interface{})["authentication"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"node-role.kubernetes.io/infra": ""}, authentication["nodeSelector"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "dedicated", "operator": "Exists"}}, authentication["tolerations"])
+	assert.NotContains(t, authentication, "affinity")
+	assert.NotContains(t, authentication, "topologySpreadConstraints")
+
+	keycloak := findPlacementService(configs, "keycloak-operator")
+	require.NotNil(t, keycloak)
+	podSpec := keycloak["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["unsupported"].(map[string]interface{})["podTemplate"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Contains(t, podSpec, "nodeSelector")
+	assert.Contains(t, podSpec, "tolerations")
+
+	db := findPlacementService(configs, "common-service-postgresql")
+	require.NotNil(t, db)
+	clusterSpec := db["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	affinity := clusterSpec["affinity"].(map[string]interface{})
+	assert.Contains(t, affinity, "nodeSelector")
+	assert.Contains(t, affinity, "tolerations")
+	assert.NotContains(t, affinity, "nodeAffinity")
+	assert.NotContains(t, clusterSpec, "topologySpreadConstraints")
+}
+
+func TestRenderPodPlacement_ServiceOverride(t *testing.T) {
+	zone := corev1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.ScheduleAnyway}
+	configs, err := renderPodPlacement(&apiv3.Placement{
+		PodPlacement: apiv3.PodPlacement{
+			NodeSelector: map[string]string{"tier": "global"},
+		},
+		Services: []apiv3.ServicePlacement{
+			{Name: "ibm-im-operator", PodPlacement: apiv3.PodPlacement{NodeSelector: map[string]string{"tier": "im"}}},
+			{Name: "ibm-im-operator-v4.5", PodPlacement: apiv3.PodPlacement{NodeSelector: map[string]string{"tier": "im-v4.5"}}},
+			{Name: "common-service-cnpg", PodPlacement: apiv3.PodPlacement{
+				Affinity:                  &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zone},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	nodeSelector := func(name string) interface{} {
+		service := findPlacementService(configs, name)
+		require.NotNil(t, service, name)
+		return service["spec"].(map[string]interface{})["authentication"].(map[string]interface{})["nodeSelector"]
+	}
+	assert.Equal(t, map[string]interface{}{"tier": "im"}, nodeSelector("ibm-im-operator"))
+	assert.Equal(t, map[string]interface{}{"tier": "im"}, nodeSelector("ibm-im-operator-v4.4"))
+	assert.Equal(t, map[string]interface{}{"tier": "im-v4.5"}, nodeSelector("ibm-im-operator-v4.5"))
+
+	cnpg := findPlacementService(configs, "common-service-cnpg")
+	require.NotNil(t, cnpg)
+	clusterSpec := cnpg["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	affinity := clusterSpec["affinity"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"tier": "global"}, affinity["nodeSelector"])
+	assert.Contains(t, affinity, "additionalPodAntiAffinity")
+	assert.Len(t, clusterSpec["topologySpreadConstraints"], 1)
+}
+
+func TestRenderPodPlacement_Empty(t *testing.T) {
+	configs, err := renderPodPlacement(nil)
+	require.NoError(t, err)
+	assert.Empty(t, configs)
+
+	configs, err = renderPodPlacement(&apiv3.Placement{})
+	require.NoError(t, err)
+	assert.Empty(t, configs)
+}
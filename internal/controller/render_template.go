@@ -169,6 +169,15 @@ func (r *CommonServiceReconciler) getNewConfigs(cs *unstructured.Unstructured) (
 		}
 	}
 
+	if storage := cs.Object["spec"].(map[string]interface{})["storage"]; storage != nil {
+		klog.Info("Applying per-service storage configuration")
+		storageConfig, err := renderServiceStorage(csObject.Spec.Storage)
+		if err != nil {
+			return nil, nil, err
+		}
+		newConfigs = append(newConfigs, storageConfig...)
+	}
+
 	if labels := cs.Object["spec"].(map[string]interface{})["labels"]; labels != nil {
 		klog.Info("Applying label configuration")
 		labelset := csObject.Spec.Labels
@@ -182,36 +191,61 @@ func (r *CommonServiceReconciler) getNewConfigs(cs *unstructured.Unstructured) (
 		}
 	}
 
-	klog.Info("Applying size configuration")
-	var sizeConfigs []interface{}
-	serviceControllerMapping := make(map[string]string)
-	serviceControllerMapping["profileController"] = "default"
-	if controller, ok := cs.Object["spec"].(map[string]interface{})["profileController"]; ok {
-		serviceControllerMapping["profileController"] = controller.(string)
+	if placement := cs.Object["spec"].(map[string]interface{})["placement"]; placement != nil {
+		klog.Info("Applying pod placement configuration")
+		placementConfig, err := renderPodPlacement(csObject.Spec.Placement)
+		if err != nil {
+			return nil, nil, err
+		}
+		newConfigs = append(newConfigs, placementConfig...)
 	}
 
-	switch cs.Object["spec"].(map[string]interface{})["size"] {
-	case "starterset", "starter":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.StarterSet, serviceControllerMapping, r.CSData.ServicesNs)
+	if priorityClassName := cs.Object["spec"].(map[string]interface{})["priorityClassName"]; priorityClassName != nil {
+		klog.Info("Applying priority class configuration")
+		priorityClassConfig, err := convertStringToSlice(constant.RenderPriorityClassTemplate(priorityClassName.(string)))
 		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
+			return nil, nil, err
 		}
-	case "small":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Small, serviceControllerMapping, r.CSData.ServicesNs)
+		newConfigs = append(newConfigs, priorityClassConfig...)
+	}
+
+	if env := util.ProxyEnv(csObject.Spec.Proxy, r.CSData.ServicesNs, cs.GetNamespace()); len(env) > 0 {
+		klog.Info("Applying proxy configuration")
+		envBytes, err := json.Marshal(env)
 		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
+			return nil, nil, err
 		}
-	case "medium":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Medium, serviceControllerMapping, r.CSData.ServicesNs)
+		proxyConfig, err := convertStringToSlice(strings.ReplaceAll(constant.ProxyTemplate, "placeholder", string(envBytes)))
 		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
+			return nil, nil, err
 		}
-	case "large", "production":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Large, serviceControllerMapping, r.CSData.ServicesNs)
+		newConfigs = append(newConfigs, proxyConfig...)
+	}
+
+	if tlsSecurityProfile := cs.Object["spec"].(map[string]interface{})["tlsSecurityProfile"]; tlsSecurityProfile != nil {
+		klog.Info("Applying TLS security profile configuration")
+		tlsConfig, err := renderTLSProfile(csObject.Spec.TLSSecurityProfile)
+		if err != nil {
+			return nil, nil, err
+		}
+		newConfigs = append(newConfigs, tlsConfig...)
+	}
+
+	klog.Info("Applying size configuration")
+	var sizeConfigs []interface{}
+	serviceControllerMapping := make(map[string]string)
+	serviceControllerMapping["profileController"] = "default"
+	if controller, ok := cs.Object["spec"].(map[string]interface{})["profileController"]; ok {
+		serviceControllerMapping["profileController"] = controller.(string)
+	}
+
+	csSize, _ := cs.Object["spec"].(map[string]interface{})["size"].(string)
+	if sizeTemplate, ok := size.Lookup(csSize); ok {
+		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, sizeTemplate, serviceControllerMapping, r.CSData.ServicesNs)
 		if err != nil {
 			return sizeConfigs, serviceControllerMapping, err
 		}
-	default:
+	} else {
 		sizeConfigs, serviceControllerMapping = applySizeConfigs(cs, serviceControllerMapping)
 	}
 	newConfigs = append(newConfigs, sizeConfigs...)
@@ -248,6 +282,21 @@ func applySizeTemplate(cs *unstructured.Unstructured, sizeTemplate string, servi
 		return nil, nil, err
 	}
 
+	if sizeFactors, ok := cs.Object["spec"].(map[string]interface{})["sizeFactors"]; ok {
+		factors := &apiv3.SizeFactors{}
+		factorsBytes, err := json.Marshal(sizeFactors)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(factorsBytes, factors); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse size factors: %v", err)
+		}
+		if err := applySizeFactors(sizes, factors); err != nil {
+			klog.Errorf("apply size factors: %v", err)
+			return nil, nil, err
+		}
+	}
+
 	for i, configSize := range sizes {
 		if configSize == nil {
 			continue
@@ -0,0 +1,124 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// ValidateRestore checks that the restore has a name and exactly one source
+func ValidateRestore(restore *apiv3.Restore) error {
+	if restore == nil {
+		return nil
+	}
+	if restore.Name == "" {
+		return fmt.Errorf("restore must set name")
+	}
+	if (restore.Backup == "") == (restore.ObjectStore == nil) {
+		return fmt.Errorf("restore must set either backup or objectStore")
+	}
+	if store := restore.ObjectStore; store != nil {
+		if store.DestinationPath == "" || store.CredentialsSecret == "" {
+			return fmt.Errorf("restore objectStore must set destinationPath and credentialsSecret")
+		}
+	}
+	if restore.ServerName != "" && restore.ObjectStore == nil {
+		return fmt.Errorf("restore serverName is only used with objectStore")
+	}
+	if restore.TargetTime != "" {
+		if _, err := time.Parse(time.RFC3339, restore.TargetTime); err != nil {
+			return fmt.Errorf("invalid restore targetTime %q, it must be in RFC 3339 format: %v", restore.TargetTime, err)
+		}
+	}
+	return nil
+}
+
+// restoreStarted returns true when the consumers of common-service-db are
+// scaled down, and the Cluster can be recreated from the backup
+func restoreStarted(cs *apiv3.CommonService) bool {
+	restore, status := cs.Spec.Restore, cs.Status.Restore
+	if restore == nil || status == nil || status.Name != restore.Name {
+		return false
+	}
+	return status.Phase == apiv3.RestoreRecovering || status.Phase == apiv3.RestoreCompleted
+}
+
+// recoveryClusterSpec returns the bootstrap and the external clusters of the
+// Cluster recovered from the backup
+func recoveryClusterSpec(restore *apiv3.Restore) map[string]interface{} {
+	recovery := map[string]interface{}{}
+	if restore.Backup != "" {
+		recovery["backup"] = map[string]interface{}{"name": restore.Backup}
+	} else {
+		recovery["source"] = constant.CSPGRestoreSource
+	}
+	if restore.TargetTime != "" {
+		recovery["recoveryTarget"] = map[string]interface{}{"targetTime": restore.TargetTime}
+	}
+
+	spec := map[string]interface{}{
+		// initdb is removed from the base template, the Cluster is created
+		// from the backup instead of an empty database
+		"bootstrap": map[string]interface{}{
+			"initdb":   nil,
+			"recovery": recovery,
+		},
+	}
+	if store := restore.ObjectStore; store != nil {
+		objectStore := backupClusterSpec(&apiv3.Backup{ObjectStore: store})["barmanObjectStore"].(map[string]interface{})
+		if restore.ServerName != "" {
+			objectStore["serverName"] = restore.ServerName
+		}
+		spec["externalClusters"] = []interface{}{
+			map[string]interface{}{
+				"name":              constant.CSPGRestoreSource,
+				"barmanObjectStore": objectStore,
+			},
+		}
+	}
+	return spec
+}
+
+// extractRestoreConfigs converts the restore to the OperandConfig format, the
+// recovery bootstrap is merged into the common-service-db Cluster
+func extractRestoreConfigs(restore *apiv3.Restore) ([]interface{}, error) {
+	if err := ValidateRestore(restore); err != nil {
+		return nil, err
+	}
+
+	var configs []interface{}
+	for _, cluster := range backupClusters {
+		configs = append(configs, map[string]interface{}{
+			"name": cluster.service,
+			"resources": []interface{}{
+				map[string]interface{}{
+					"apiVersion": cluster.apiVersion,
+					"kind":       "Cluster",
+					"name":       constant.CSPGCluster,
+					"data": map[string]interface{}{
+						"spec": recoveryClusterSpec(restore),
+					},
+				},
+			},
+		})
+	}
+	return configs, nil
+}
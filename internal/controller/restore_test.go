@@ -0,0 +1,89 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestValidateRestore(t *testing.T) {
+	store := &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs", CredentialsSecret: "backup-creds"}
+
+	assert.NoError(t, ValidateRestore(nil))
+	assert.NoError(t, ValidateRestore(&apiv3.Restore{Name: "r1", Backup: "common-service-db-backup-20261018"}))
+	assert.NoError(t, ValidateRestore(&apiv3.Restore{Name: "r1", ObjectStore: store, ServerName: "cs-db", TargetTime: "2026-10-18T09:30:00Z"}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Backup: "b1"}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Name: "r1"}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Name: "r1", Backup: "b1", ObjectStore: store}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Name: "r1", ObjectStore: &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs"}}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Name: "r1", Backup: "b1", ServerName: "cs-db"}))
+	assert.Error(t, ValidateRestore(&apiv3.Restore{Name: "r1", Backup: "b1", TargetTime: "2026-10-18 09:30"}))
+}
+
+func TestExtractRestoreConfigs(t *testing.T) {
+	configs, err := extractRestoreConfigs(&apiv3.Restore{
+		Name:        "r1",
+		ObjectStore: &apiv3.BackupObjectStore{DestinationPath: "s3://backups/cs", CredentialsSecret: "backup-creds"},
+		ServerName:  "cs-db",
+		TargetTime:  "2026-10-18T09:30:00Z",
+	})
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+
+	resources := configs[1].(map[string]interface{})["resources"].([]interface{})
+	cluster := resources[0].(map[string]interface{})
+	assert.Equal(t, "pg.ibm.com/v1", cluster["apiVersion"])
+	spec := cluster["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"initdb": nil,
+		"recovery": map[string]interface{}{
+			"source":         "common-service-db-restore-source",
+			"recoveryTarget": map[string]interface{}{"targetTime": "2026-10-18T09:30:00Z"},
+		},
+	}, spec["bootstrap"])
+	externalCluster := spec["externalClusters"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "common-service-db-restore-source", externalCluster["name"])
+	objectStore := externalCluster["barmanObjectStore"].(map[string]interface{})
+	assert.Equal(t, "s3://backups/cs", objectStore["destinationPath"])
+	assert.Equal(t, "cs-db", objectStore["serverName"])
+
+	configs, err = extractRestoreConfigs(&apiv3.Restore{Name: "r1", Backup: "b1"})
+	require.NoError(t, err)
+	spec = configs[0].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"backup": map[string]interface{}{"name": "b1"}}, spec["bootstrap"].(map[string]interface{})["recovery"])
+	assert.NotContains(t, spec, "externalClusters")
+}
+
+func TestRestoreStarted(t *testing.T) {
+	cs := &apiv3.CommonService{Spec: apiv3.CommonServiceSpec{Restore: &apiv3.Restore{Name: "r2", Backup: "b1"}}}
+	assert.False(t, restoreStarted(cs))
+
+	cs.Status.Restore = &apiv3.RestoreStatus{Name: "r2", Phase: apiv3.RestoreScalingDown}
+	assert.False(t, restoreStarted(cs))
+
+	cs.Status.Restore.Phase = apiv3.RestoreRecovering
+	assert.True(t, restoreStarted(cs))
+
+	// the status of a previous restore doesn't start a new one
+	cs.Status.Restore = &apiv3.RestoreStatus{Name: "r1", Phase: apiv3.RestoreCompleted}
+	assert.False(t, restoreStarted(cs))
+}
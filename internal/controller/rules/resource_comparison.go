@@ -54,6 +54,12 @@ func normalizeResourceQuantity(quantity string) string {
 	return quantity
 }
 
+// ParseResourceQuantity parses a resource quantity of a size configuration,
+// which may be a string or a number
+func ParseResourceQuantity(value interface{}) (resource.Quantity, error) {
+	return resource.ParseQuantity(normalizeResourceQuantity(fmt.Sprintf("%v", value)))
+}
+
 func resourceStringComparison(resourceA, resourceB string) (string, string, error) {
 	if sizeA, ok := profileSize[resourceA]; ok {
 		if sizeB, ok := profileSize[resourceB]; ok {
@@ -0,0 +1,82 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package rules
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ParseFactor parses a decimal scaling factor, e.g. "1.5", which must be positive
+func ParseFactor(factor string) (resource.Quantity, error) {
+	quantity, err := resource.ParseQuantity(factor)
+	if err != nil {
+		return quantity, fmt.Errorf("invalid scaling factor %q: %v", factor, err)
+	}
+	if quantity.Sign() <= 0 {
+		return quantity, fmt.Errorf("invalid scaling factor %q: it must be positive", factor)
+	}
+	return quantity, nil
+}
+
+// ScaleQuantity multiplies a resource quantity by the factor. The result is
+// rounded up to the given scale, e.g. resource.Milli for CPU and 0 for memory,
+// and keeps the format of the quantity so it can be compared by ResourceComparison.
+func ScaleQuantity(value interface{}, factor resource.Quantity, scale resource.Scale) (string, error) {
+	quantity, err := ParseResourceQuantity(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to scale resource %v: %v", value, err)
+	}
+
+	dec := quantity.AsDec()
+	dec.Mul(dec, factor.AsDec())
+	scaled := resource.NewDecimalQuantity(*dec, quantity.Format)
+	scaled.RoundUp(scale)
+	return scaled.String(), nil
+}
+
+// ScaleReplicas multiplies a replica count by the factor. The result is rounded
+// up and is never less than one, except for zero replicas that are kept.
+func ScaleReplicas(value interface{}, factor resource.Quantity) (int64, error) {
+	var replicas resource.Quantity
+	switch value := value.(type) {
+	case int:
+		replicas = *resource.NewQuantity(int64(value), resource.DecimalSI)
+	case int64:
+		replicas = *resource.NewQuantity(value, resource.DecimalSI)
+	case float64:
+		if value != float64(int64(value)) {
+			return 0, fmt.Errorf("failed to scale replicas %v: not an integer", value)
+		}
+		replicas = *resource.NewQuantity(int64(value), resource.DecimalSI)
+	default:
+		return 0, fmt.Errorf("failed to scale replicas %v: not a number", value)
+	}
+
+	if replicas.IsZero() {
+		return 0, nil
+	}
+	dec := replicas.AsDec()
+	dec.Mul(dec, factor.AsDec())
+	scaled := resource.NewDecimalQuantity(*dec, resource.DecimalSI)
+	scaled.RoundUp(0)
+	if scaled.Value() < 1 {
+		return 1, nil
+	}
+	return scaled.Value(), nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package rules
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Resource Scaling", func() {
+
+	Context("Parse factor", func() {
+		It("Should accept a positive decimal", func() {
+			factor, err := ParseFactor("1.5")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(factor.String()).Should(Equal("1500m"))
+		})
+		It("Should reject zero, negative and invalid factors", func() {
+			for _, factor := range []string{"0", "-1", "double"} {
+				_, err := ParseFactor(factor)
+				Expect(err).To(HaveOccurred())
+			}
+		})
+	})
+
+	Context("Scale quantity", func() {
+		It("Should round CPU up to millicores", func() {
+			result, err := ScaleQuantity("75m", resource.MustParse("0.5"), resource.Milli)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).Should(Equal("38m"))
+		})
+		It("Should scale numeric CPU", func() {
+			result, err := ScaleQuantity(float64(2), resource.MustParse("1.5"), resource.Milli)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).Should(Equal("3"))
+		})
+		It("Should keep the binary format of memory", func() {
+			result, err := ScaleQuantity("512Mi", resource.MustParse("1.5"), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).Should(Equal("768Mi"))
+		})
+		It("Should normalize the units before scaling", func() {
+			result, err := ScaleQuantity("64MB", resource.MustParse("2"), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).Should(Equal("128M"))
+		})
+		It("Should stay comparable by ResourceComparison", func() {
+			result, err := ScaleQuantity("1Gi", resource.MustParse("0.5"), 0)
+			Expect(err).NotTo(HaveOccurred())
+			large, _ := ResourceComparison(result, "600Mi")
+			Expect(large).Should(Equal("600Mi"))
+		})
+		It("Should reject an invalid quantity", func() {
+			_, err := ScaleQuantity("lots", resource.MustParse("2"), 0)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Scale replicas", func() {
+		It("Should round up and keep at least one replica", func() {
+			replicas, err := ScaleReplicas(float64(3), resource.MustParse("0.5"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).Should(Equal(int64(2)))
+
+			replicas, err = ScaleReplicas(1, resource.MustParse("0.1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).Should(Equal(int64(1)))
+		})
+		It("Should keep zero replicas", func() {
+			replicas, err := ScaleReplicas(0, resource.MustParse("1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).Should(Equal(int64(0)))
+
+			replicas, err = ScaleReplicas(float64(0), resource.MustParse("2.5"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).Should(Equal(int64(0)))
+		})
+		It("Should reject a fractional replica count", func() {
+			_, err := ScaleReplicas(1.5, resource.MustParse("2"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,188 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// validAccessModes are the access modes of a PersistentVolumeClaim
+var validAccessModes = map[corev1.PersistentVolumeAccessMode]bool{
+	corev1.ReadWriteOnce:    true,
+	corev1.ReadOnlyMany:     true,
+	corev1.ReadWriteMany:    true,
+	corev1.ReadWriteOncePod: true,
+}
+
+// storageForService returns the storage of the service, the storage of a
+// service applies to all its versions
+func storageForService(storage []apiv3.ServiceStorage, name string) *apiv3.ServiceStorage {
+	for i := range storage {
+		if storage[i].Name == name || strings.HasPrefix(name, storage[i].Name+"-v") {
+			return &storage[i]
+		}
+	}
+	return nil
+}
+
+// storageValues maps the placeholders of the template to the values of the
+// storage, the placeholders of the fields not set are left out
+func storageValues(storage *apiv3.ServiceStorage) map[string]interface{} {
+	values := map[string]interface{}{}
+	if storage.StorageClass != "" {
+		values[constant.PlaceholderStorageClass] = storage.StorageClass
+	}
+	if storage.Size != nil {
+		values[constant.PlaceholderStorageSize] = storage.Size.String()
+	}
+	if len(storage.AccessModes) > 0 {
+		accessModes := make([]interface{}, 0, len(storage.AccessModes))
+		for _, mode := range storage.AccessModes {
+			accessModes = append(accessModes, string(mode))
+		}
+		values[constant.PlaceholderAccessModes] = accessModes
+	}
+	return values
+}
+
+// renderServiceStorage renders the service storage template for each service
+// with storage settings
+func renderServiceStorage(storage []apiv3.ServiceStorage) ([]interface{}, error) {
+	if len(storage) == 0 {
+		return nil, nil
+	}
+	services, err := convertStringToSlice(constant.ServiceStorageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []interface{}
+	for _, item := range services {
+		service, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := service["name"].(string)
+		serviceStorage := storageForService(storage, name)
+		if serviceStorage == nil {
+			continue
+		}
+		values := storageValues(serviceStorage)
+		if len(values) == 0 {
+			continue
+		}
+		for _, key := range []string{"spec", "resources"} {
+			if config, ok := service[key]; ok && replacePlaceholders(config, values) {
+				delete(service, key)
+			}
+		}
+		if _, ok := service["spec"]; !ok {
+			if _, ok := service["resources"]; !ok {
+				continue
+			}
+		}
+		configs = append(configs, service)
+	}
+	return configs, nil
+}
+
+// storagePlaceholders returns the placeholders of each service in the service
+// storage template
+func storagePlaceholders() (map[string]map[string]bool, error) {
+	services, err := convertStringToSlice(constant.ServiceStorageTemplate)
+	if err != nil {
+		return nil, err
+	}
+	placeholders := map[string]map[string]bool{}
+	var collect func(name string, config interface{})
+	collect = func(name string, config interface{}) {
+		switch config := config.(type) {
+		case map[string]interface{}:
+			for _, value := range config {
+				collect(name, value)
+			}
+		case []interface{}:
+			for _, value := range config {
+				collect(name, value)
+			}
+		case string:
+			placeholders[name][config] = true
+		}
+	}
+	for _, item := range services {
+		service, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := service["name"].(string)
+		placeholders[name] = map[string]bool{}
+		collect(name, service["spec"])
+		collect(name, service["resources"])
+	}
+	return placeholders, nil
+}
+
+// ValidateServiceStorage checks that each service has storage settings, and
+// supports the volume size and the access modes when they are set
+func ValidateServiceStorage(storage []apiv3.ServiceStorage) error {
+	if len(storage) == 0 {
+		return nil
+	}
+	placeholders, err := storagePlaceholders()
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, serviceStorage := range storage {
+		fields, ok := placeholders[serviceStorage.Name]
+		if !ok {
+			return fmt.Errorf("service %q doesn't have storage settings", serviceStorage.Name)
+		}
+		if seen[serviceStorage.Name] {
+			return fmt.Errorf("storage of service %s is set more than once", serviceStorage.Name)
+		}
+		seen[serviceStorage.Name] = true
+
+		if serviceStorage.StorageClass == "" && serviceStorage.Size == nil && len(serviceStorage.AccessModes) == 0 {
+			return fmt.Errorf("storage of service %s must set the storageClass, size, or accessModes", serviceStorage.Name)
+		}
+		if serviceStorage.Size != nil {
+			if !fields[constant.PlaceholderStorageSize] {
+				return fmt.Errorf("service %s doesn't support the volume size", serviceStorage.Name)
+			}
+			if serviceStorage.Size.Sign() <= 0 {
+				return fmt.Errorf("volume size of service %s must be positive", serviceStorage.Name)
+			}
+		}
+		if len(serviceStorage.AccessModes) > 0 && !fields[constant.PlaceholderAccessModes] {
+			return fmt.Errorf("service %s doesn't support the access modes", serviceStorage.Name)
+		}
+		for _, mode := range serviceStorage.AccessModes {
+			if !validAccessModes[mode] {
+				return fmt.Errorf("invalid access mode %q of service %s", mode, serviceStorage.Name)
+			}
+		}
+	}
+	return nil
+}
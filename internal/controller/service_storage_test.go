@@ -0,0 +1,80 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+func TestRenderServiceStorage(t *testing.T) {
+	size := resource.MustParse("50Gi")
+	configs, err := renderServiceStorage([]apiv3.ServiceStorage{
+		{Name: "common-service-postgresql", StorageClass: "fast-block", Size: &size, AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+		{Name: "ibm-im-mongodb-operator", StorageClass: "standard"},
+	})
+	require.NoError(t, err)
+
+	var names []string
+	for _, c := range configs {
+		service := c.(map[string]interface{})
+		names = append(names, service["name"].(string))
+		if service["name"] != "common-service-postgresql" {
+			assert.Equal(t, "standard", service["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["storageClass"])
+			continue
+		}
+		spec := service["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+		expected := map[string]interface{}{
+			"storageClass": "fast-block",
+			"size":         "50Gi",
+			"pvcTemplate":  map[string]interface{}{"accessModes": []interface{}{"ReadWriteOnce"}},
+		}
+		assert.Equal(t, expected, spec["storage"])
+		assert.Equal(t, expected, spec["walStorage"])
+	}
+	assert.Equal(t, []string{"ibm-im-mongodb-operator", "ibm-im-mongodb-operator-v4.0", "ibm-im-mongodb-operator-v4.1", "ibm-im-mongodb-operator-v4.2", "common-service-postgresql"}, names)
+
+	// the services without a size or access modes keep the template values
+	configs, err = renderServiceStorage([]apiv3.ServiceStorage{{Name: "edb-keycloak", StorageClass: "standard"}})
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	spec := configs[0].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"storageClass": "standard"}, spec["storage"])
+}
+
+func TestValidateServiceStorage(t *testing.T) {
+	size := resource.MustParse("20Gi")
+	zero := resource.MustParse("0")
+	assert.NoError(t, ValidateServiceStorage(nil))
+	assert.NoError(t, ValidateServiceStorage([]apiv3.ServiceStorage{
+		{Name: "common-service-cnpg", Size: &size, AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod}},
+		{Name: "ibm-healthcheck-operator", StorageClass: "standard"},
+	}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "ibm-im-operator", StorageClass: "standard"}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "edb-keycloak"}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "edb-keycloak", StorageClass: "a"}, {Name: "edb-keycloak", StorageClass: "b"}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "ibm-im-mongodb-operator", Size: &size}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "ibm-apicatalog", AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "edb-keycloak", Size: &zero}}))
+	assert.Error(t, ValidateServiceStorage([]apiv3.ServiceStorage{{Name: "edb-keycloak", AccessModes: []corev1.PersistentVolumeAccessMode{"ReadWrite"}}}))
+}
@@ -0,0 +1,283 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package size
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	utilyaml "github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Priorities of the built-in size profiles. They are spaced out so that
+// user-defined profiles can be ranked in between.
+const (
+	StarterSetPriority = 100
+	SmallPriority      = 200
+	MediumPriority     = 300
+	LargePriority      = 400
+)
+
+var builtinPriorities = map[string]int{
+	StarterSetProfile: StarterSetPriority,
+	SmallProfile:      SmallPriority,
+	MediumProfile:     MediumPriority,
+	LargeProfile:      LargePriority,
+}
+
+// aliases are the other names accepted in Spec.Size for the built-in profiles
+var aliases = map[string]string{
+	"starter":    StarterSetProfile,
+	"production": LargeProfile,
+}
+
+// AutoProfile is the size resolved to the built-in profile chosen from the
+// cluster capacity, see SetAutoProfile
+const AutoProfile = "auto"
+
+// Profile is a user-defined size profile
+type Profile struct {
+	// Name is the value to set in Spec.Size to select the profile
+	Name string
+	// Priority ranks the profile when the largest size across all
+	// CommonService CRs is selected, it must not be shared with another profile
+	Priority int
+	// Inherits is the optional built-in profile the services are merged into
+	Inherits string
+	// Services is the YAML list of services, in the same format as the
+	// built-in profiles
+	Services string
+}
+
+type customProfile struct {
+	priority int
+	template string
+}
+
+var (
+	customMutex    sync.RWMutex
+	customProfiles = map[string]customProfile{}
+	autoProfile    string
+)
+
+// SetCustomProfiles validates the given profiles and replaces the registered
+// user-defined profiles with the valid ones. It returns an error for each
+// profile that was skipped.
+func SetCustomProfiles(profiles []Profile) []error {
+	sorted := make([]Profile, len(profiles))
+	copy(sorted, profiles)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var errs []error
+	registered := map[string]customProfile{}
+	ranks := map[int]string{}
+
+	for _, profile := range sorted {
+		if _, ok := registered[profile.Name]; ok {
+			errs = append(errs, fmt.Errorf("size profile %q is defined more than once", profile.Name))
+			continue
+		}
+		template, err := renderProfile(profile)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if other, ok := ranks[profile.Priority]; ok {
+			errs = append(errs, fmt.Errorf("size profile %q has the same priority %d as profile %q", profile.Name, profile.Priority, other))
+			continue
+		}
+		ranks[profile.Priority] = profile.Name
+		registered[profile.Name] = customProfile{priority: profile.Priority, template: template}
+	}
+
+	customMutex.Lock()
+	defer customMutex.Unlock()
+	customProfiles = registered
+	return errs
+}
+
+// SetAutoProfile sets the built-in profile the auto size resolves to, an
+// empty profile leaves the auto size unresolved
+func SetAutoProfile(profile string) error {
+	if _, ok := builtinPriorities[profile]; !ok && profile != "" {
+		return fmt.Errorf("auto size must resolve to a built-in profile, got %q", profile)
+	}
+	customMutex.Lock()
+	defer customMutex.Unlock()
+	autoProfile = profile
+	return nil
+}
+
+// GetAutoProfile returns the built-in profile the auto size resolves to
+func GetAutoProfile() string {
+	customMutex.RLock()
+	defer customMutex.RUnlock()
+	return autoProfile
+}
+
+// resolveName maps the aliases and the auto size to a built-in profile name
+func resolveName(name string) string {
+	if name == AutoProfile {
+		return GetAutoProfile()
+	}
+	if alias, ok := aliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// ValidateProfile checks a user-defined profile on its own, without comparing
+// it with the other registered profiles
+func ValidateProfile(profile Profile) error {
+	_, err := renderProfile(profile)
+	return err
+}
+
+// Lookup returns the size template of a built-in or user-defined profile, or
+// of the built-in profile the auto size resolves to
+func Lookup(name string) (string, bool) {
+	switch resolveName(name) {
+	case StarterSetProfile:
+		return StarterSet, true
+	case SmallProfile:
+		return Small, true
+	case MediumProfile:
+		return Medium, true
+	case LargeProfile:
+		return Large, true
+	}
+
+	customMutex.RLock()
+	defer customMutex.RUnlock()
+	profile, ok := customProfiles[name]
+	return profile.template, ok
+}
+
+// Priority returns the rank of a built-in or user-defined profile, a larger
+// value means a larger profile
+func Priority(name string) (int, bool) {
+	name = resolveName(name)
+	if priority, ok := builtinPriorities[name]; ok {
+		return priority, true
+	}
+
+	customMutex.RLock()
+	defer customMutex.RUnlock()
+	profile, ok := customProfiles[name]
+	return profile.priority, ok
+}
+
+// renderProfile validates a user-defined profile and merges its services
+// into the inherited built-in profile
+func renderProfile(profile Profile) (string, error) {
+	if errs := validation.IsDNS1123Label(profile.Name); len(errs) > 0 {
+		return "", fmt.Errorf("size profile name %q is invalid: %v", profile.Name, errs)
+	}
+	if _, ok := builtinPriorities[profile.Name]; ok {
+		return "", fmt.Errorf("size profile %q conflicts with a built-in profile", profile.Name)
+	}
+	if _, ok := aliases[profile.Name]; ok || profile.Name == AutoProfile {
+		return "", fmt.Errorf("size profile %q conflicts with a built-in profile", profile.Name)
+	}
+	if profile.Priority <= 0 {
+		return "", fmt.Errorf("size profile %q must have a positive priority, got %d", profile.Name, profile.Priority)
+	}
+	for name, priority := range builtinPriorities {
+		if profile.Priority == priority {
+			return "", fmt.Errorf("size profile %q has the same priority %d as profile %q", profile.Name, profile.Priority, name)
+		}
+	}
+
+	services, err := yamlToSlice(profile.Services)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse services of size profile %q: %v", profile.Name, err)
+	}
+	for _, service := range services {
+		if err := validateService(service); err != nil {
+			return "", fmt.Errorf("size profile %q: %v", profile.Name, err)
+		}
+	}
+
+	if profile.Inherits == "" {
+		if len(services) == 0 {
+			return "", fmt.Errorf("size profile %q has no services and does not inherit a built-in profile", profile.Name)
+		}
+		rendered, err := utilyaml.Marshal(services)
+		if err != nil {
+			return "", err
+		}
+		return string(rendered), nil
+	}
+
+	inherits := profile.Inherits
+	if alias, ok := aliases[inherits]; ok {
+		inherits = alias
+	}
+	if _, ok := builtinPriorities[inherits]; !ok {
+		return "", fmt.Errorf("size profile %q inherits unknown built-in profile %q", profile.Name, profile.Inherits)
+	}
+	base, err := Render(inherits, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+	baseSlice, err := yamlToSlice(base)
+	if err != nil {
+		return "", err
+	}
+	rendered, err := utilyaml.Marshal(mergeOverlay(baseSlice, services))
+	if err != nil {
+		return "", err
+	}
+	return string(rendered), nil
+}
+
+func validateService(service interface{}) error {
+	serviceMap, ok := service.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("service entry %v is not a map", service)
+	}
+	name, ok := serviceMap["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf("service entry %v has no name", service)
+	}
+	if spec, ok := serviceMap["spec"]; ok {
+		if _, ok := spec.(map[string]interface{}); !ok {
+			return fmt.Errorf("spec of service %s is not a map", name)
+		}
+	}
+	if resources, ok := serviceMap["resources"]; ok {
+		resourceList, ok := resources.([]interface{})
+		if !ok {
+			return fmt.Errorf("resources of service %s is not a list", name)
+		}
+		for _, resource := range resourceList {
+			resourceMap, ok := resource.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("resource %v of service %s is not a map", resource, name)
+			}
+			for _, field := range []string{"apiVersion", "kind", "name"} {
+				if value, ok := resourceMap[field].(string); !ok || value == "" {
+					return fmt.Errorf("resource %v of service %s has no %s", resource, name, field)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package size
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const edgeServices = `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 1
+`
+
+func TestBuiltinProfiles(t *testing.T) {
+	for _, name := range append(Profiles(), "starter", "production") {
+		template, ok := Lookup(name)
+		assert.Truef(t, ok, "profile %s", name)
+		assert.NotEmptyf(t, template, "profile %s", name)
+		_, ok = Priority(name)
+		assert.Truef(t, ok, "profile %s", name)
+	}
+
+	starter, _ := Priority("starter")
+	small, _ := Priority(SmallProfile)
+	medium, _ := Priority(MediumProfile)
+	production, _ := Priority("production")
+	assert.Less(t, starter, small)
+	assert.Less(t, small, medium)
+	assert.Less(t, medium, production)
+
+	_, ok := Lookup("xlarge")
+	assert.False(t, ok)
+	_, ok = Priority("")
+	assert.False(t, ok)
+}
+
+func TestSetCustomProfiles(t *testing.T) {
+	defer SetCustomProfiles(nil)
+
+	errs := SetCustomProfiles([]Profile{
+		{Name: "xlarge", Priority: 500, Inherits: LargeProfile, Services: edgeServices},
+		{Name: "edge", Priority: 50, Services: edgeServices},
+	})
+	require.Empty(t, errs)
+
+	priority, ok := Priority("xlarge")
+	require.True(t, ok)
+	largePriority, _ := Priority(LargeProfile)
+	assert.Greater(t, priority, largePriority)
+
+	xlarge, ok := Lookup("xlarge")
+	require.True(t, ok)
+	xlargeNames, err := ServiceNames(xlarge)
+	require.NoError(t, err)
+	largeNames, err := ServiceNames(Large)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, largeNames, xlargeNames)
+
+	xlargeSlice, err := yamlToSlice(xlarge)
+	require.NoError(t, err)
+	for _, service := range xlargeSlice {
+		serviceMap := service.(map[string]interface{})
+		if serviceMap["name"] != "ibm-im-operator" {
+			continue
+		}
+		auth := serviceMap["spec"].(map[string]interface{})["authentication"].(map[string]interface{})
+		assert.Equal(t, float64(1), auth["replicas"])
+		// values not set by the custom profile are inherited
+		assert.NotNil(t, auth["authService"])
+	}
+
+	edge, ok := Lookup("edge")
+	require.True(t, ok)
+	edgeNames, err := ServiceNames(edge)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ibm-im-operator"}, edgeNames)
+
+	// profiles are replaced on every call
+	require.Empty(t, SetCustomProfiles(nil))
+	_, ok = Lookup("xlarge")
+	assert.False(t, ok)
+}
+
+func TestSetCustomProfilesInvalid(t *testing.T) {
+	defer SetCustomProfiles(nil)
+
+	tests := []struct {
+		name    string
+		profile Profile
+	}{
+		{"built-in name", Profile{Name: MediumProfile, Priority: 500, Services: edgeServices}},
+		{"alias name", Profile{Name: "production", Priority: 500, Services: edgeServices}},
+		{"invalid name", Profile{Name: "X_Large", Priority: 500, Services: edgeServices}},
+		{"zero priority", Profile{Name: "edge", Services: edgeServices}},
+		{"built-in priority", Profile{Name: "edge", Priority: SmallPriority, Services: edgeServices}},
+		{"unknown inherits", Profile{Name: "edge", Priority: 500, Inherits: "huge", Services: edgeServices}},
+		{"no services", Profile{Name: "edge", Priority: 500}},
+		{"services not a list", Profile{Name: "edge", Priority: 500, Services: "name: ibm-im-operator"}},
+		{"service without name", Profile{Name: "edge", Priority: 500, Services: "- spec: {}"}},
+		{"resource without kind", Profile{Name: "edge", Priority: 500, Services: `
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    name: common-service-db
+`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, ValidateProfile(tt.profile))
+			errs := SetCustomProfiles([]Profile{tt.profile})
+			assert.Len(t, errs, 1)
+		})
+	}
+
+	// the second profile with the same priority is skipped, the first one is kept
+	errs := SetCustomProfiles([]Profile{
+		{Name: "edge-b", Priority: 50, Services: edgeServices},
+		{Name: "edge-a", Priority: 50, Services: edgeServices},
+	})
+	assert.Len(t, errs, 1)
+	_, ok := Lookup("edge-a")
+	assert.True(t, ok)
+	_, ok = Lookup("edge-b")
+	assert.False(t, ok)
+}
+
+func TestAutoProfile(t *testing.T) {
+	defer func() { _ = SetAutoProfile("") }()
+
+	_, ok := Lookup(AutoProfile)
+	assert.False(t, ok, "auto size is unresolved until a profile is chosen")
+
+	require.NoError(t, SetAutoProfile(MediumProfile))
+	template, ok := Lookup(AutoProfile)
+	require.True(t, ok)
+	assert.Equal(t, Medium, template)
+	priority, ok := Priority(AutoProfile)
+	require.True(t, ok)
+	assert.Equal(t, MediumPriority, priority)
+
+	assert.Error(t, SetAutoProfile("xlarge"))
+	assert.Equal(t, MediumProfile, GetAutoProfile())
+	assert.Error(t, ValidateProfile(Profile{Name: AutoProfile, Priority: 500, Services: edgeServices}))
+}
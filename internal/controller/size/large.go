@@ -16,7 +16,8 @@
 
 package size
 
-const Large = `
+// largeBase is the large profile before any architecture overlay is applied.
+const largeBase = `
 - name: ibm-cert-manager-operator
   spec:
     certManager:
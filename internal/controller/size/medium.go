@@ -16,7 +16,8 @@
 
 package size
 
-const Medium = `
+// mediumBase is the medium profile before any architecture overlay is applied.
+const mediumBase = `
 - name: ibm-cert-manager-operator
   spec:
     certManager:
@@ -0,0 +1,199 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package size
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	utilyaml "github.com/ghodss/yaml"
+)
+
+// Names of the built-in size profiles
+const (
+	StarterSetProfile = "starterset"
+	SmallProfile      = "small"
+	MediumProfile     = "medium"
+	LargeProfile      = "large"
+)
+
+var baseProfiles = map[string]string{
+	StarterSetProfile: starterSetBase,
+	SmallProfile:      smallBase,
+	MediumProfile:     mediumBase,
+	LargeProfile:      largeBase,
+}
+
+// archOverlays maps an architecture (GOARCH) to the per-profile overlays
+// merged on top of the base profiles. Architectures without an entry use the
+// base profiles as they are.
+var archOverlays = map[string]map[string]string{
+	"ppc64le": ppc64leOverlays,
+	"s390x":   s390xOverlays,
+}
+
+// Size profiles rendered for the architecture the operator is running on
+var (
+	StarterSet = mustRender(StarterSetProfile, runtime.GOARCH)
+	Small      = mustRender(SmallProfile, runtime.GOARCH)
+	Medium     = mustRender(MediumProfile, runtime.GOARCH)
+	Large      = mustRender(LargeProfile, runtime.GOARCH)
+)
+
+// Profiles returns the names of the built-in size profiles
+func Profiles() []string {
+	return []string{StarterSetProfile, SmallProfile, MediumProfile, LargeProfile}
+}
+
+// Architectures returns the architectures that have an overlay
+func Architectures() []string {
+	var arches []string
+	for arch := range archOverlays {
+		arches = append(arches, arch)
+	}
+	return arches
+}
+
+// Render merges the overlay of the given architecture into the base profile
+// and returns the result as a YAML list of services
+func Render(profile, arch string) (string, error) {
+	base, ok := baseProfiles[profile]
+	if !ok {
+		return "", fmt.Errorf("unknown size profile %q", profile)
+	}
+
+	overlay, ok := archOverlays[arch][profile]
+	if !ok {
+		return base, nil
+	}
+
+	baseSlice, err := yamlToSlice(base)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s base profile: %v", profile, err)
+	}
+	overlaySlice, err := yamlToSlice(overlay)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s overlay for %s: %v", profile, arch, err)
+	}
+
+	merged, err := utilyaml.Marshal(mergeOverlay(baseSlice, overlaySlice))
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// ServiceNames returns the names of the services configured in a size profile
+func ServiceNames(profile string) ([]string, error) {
+	services, err := yamlToSlice(profile)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, service := range services {
+		serviceMap, ok := service.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("size profile entry %v is not a map", service)
+		}
+		name, ok := serviceMap["name"].(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("size profile entry %v has no name", service)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func mustRender(profile, arch string) string {
+	rendered, err := Render(profile, arch)
+	if err != nil {
+		panic(err)
+	}
+	return rendered
+}
+
+func yamlToSlice(str string) ([]interface{}, error) {
+	jsonSpec, err := utilyaml.YAMLToJSON([]byte(str))
+	if err != nil {
+		return nil, err
+	}
+	var slice []interface{}
+	if err := json.Unmarshal(jsonSpec, &slice); err != nil {
+		return nil, err
+	}
+	return slice, nil
+}
+
+// mergeOverlay deep merges overlay into base. Lists of services are matched
+// by name and lists of resources by apiVersion, kind and name, other values
+// in the overlay replace the ones in base.
+func mergeOverlay(base, overlay interface{}) interface{} {
+	switch overlay := overlay.(type) {
+	case map[string]interface{}:
+		baseMap, ok := base.(map[string]interface{})
+		if !ok {
+			return overlay
+		}
+		for key, value := range overlay {
+			baseMap[key] = mergeOverlay(baseMap[key], value)
+		}
+		return baseMap
+	case []interface{}:
+		baseSlice, ok := base.([]interface{})
+		if !ok {
+			return overlay
+		}
+		for _, item := range overlay {
+			key, ok := itemKey(item)
+			if !ok {
+				// items without identity can't be matched, replace the whole list
+				return overlay
+			}
+			matched := false
+			for i, baseItem := range baseSlice {
+				if baseKey, ok := itemKey(baseItem); ok && baseKey == key {
+					baseSlice[i] = mergeOverlay(baseItem, item)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				baseSlice = append(baseSlice, item)
+			}
+		}
+		return baseSlice
+	default:
+		return overlay
+	}
+}
+
+func itemKey(item interface{}) (string, bool) {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	name, ok := itemMap["name"].(string)
+	if !ok {
+		return "", false
+	}
+	if kind, ok := itemMap["kind"].(string); ok {
+		apiVersion, _ := itemMap["apiVersion"].(string)
+		return apiVersion + "/" + kind + "/" + name, true
+	}
+	return name, true
+}
@@ -0,0 +1,173 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package size
+
+import (
+	"testing"
+
+	utilyaml "github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+)
+
+// externalServices are configured by the size profiles but registered in the
+// OperandRegistry and OperandConfig shipped by their own product
+var externalServices = map[string]bool{
+	"ibm-apicatalog": true,
+}
+
+func TestMergeOverlay(t *testing.T) {
+	base, err := yamlToSlice(`
+- name: svc-a
+  spec:
+    cr:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+          memory: 100Mi
+  resources:
+  - apiVersion: v1
+    kind: Cluster
+    name: db
+    data:
+      spec:
+        instances: 1
+- name: svc-b
+  spec:
+    cr:
+      replicas: 2
+`)
+	require.NoError(t, err)
+	overlay, err := yamlToSlice(`
+- name: svc-a
+  spec:
+    cr:
+      resources:
+        limits:
+          memory: 200Mi
+  resources:
+  - apiVersion: v1
+    kind: Cluster
+    name: db
+    data:
+      spec:
+        instances: 3
+`)
+	require.NoError(t, err)
+
+	merged := mergeOverlay(base, overlay).([]interface{})
+	require.Len(t, merged, 2)
+
+	svcA := merged[0].(map[string]interface{})
+	cr := svcA["spec"].(map[string]interface{})["cr"].(map[string]interface{})
+	limits := cr["resources"].(map[string]interface{})["limits"].(map[string]interface{})
+	assert.Equal(t, float64(1), cr["replicas"])
+	assert.Equal(t, "100m", limits["cpu"])
+	assert.Equal(t, "200Mi", limits["memory"])
+
+	db := svcA["resources"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(3), db["data"].(map[string]interface{})["spec"].(map[string]interface{})["instances"])
+
+	svcB := merged[1].(map[string]interface{})
+	assert.Equal(t, float64(2), svcB["spec"].(map[string]interface{})["cr"].(map[string]interface{})["replicas"])
+}
+
+func TestRenderUnknownProfile(t *testing.T) {
+	_, err := Render("xlarge", "amd64")
+	assert.Error(t, err)
+}
+
+// TestOverlaysOnlyOverrideBaseServices makes sure an overlay never adds a
+// service that the base profile does not have, so all architectures configure
+// the same set of services.
+func TestOverlaysOnlyOverrideBaseServices(t *testing.T) {
+	for _, arch := range Architectures() {
+		for _, profile := range Profiles() {
+			baseNames, err := ServiceNames(baseProfiles[profile])
+			require.NoError(t, err)
+
+			overlay, ok := archOverlays[arch][profile]
+			require.Truef(t, ok, "architecture %s has no overlay for profile %s", arch, profile)
+			overlayNames, err := ServiceNames(overlay)
+			require.NoError(t, err)
+			for _, name := range overlayNames {
+				assert.Containsf(t, baseNames, name, "%s overlay for %s configures service %s missing from the base profile", arch, profile, name)
+			}
+
+			rendered, err := Render(profile, arch)
+			require.NoError(t, err)
+			renderedNames, err := ServiceNames(rendered)
+			require.NoError(t, err)
+			assert.ElementsMatchf(t, baseNames, renderedNames, "%s profile for %s", profile, arch)
+		}
+	}
+}
+
+// TestProfileServicesAreRegistered checks that every service configured by a
+// size profile exists in the OperandConfig and the OperandRegistry templates.
+func TestProfileServicesAreRegistered(t *testing.T) {
+	data := apiv3.CSData{
+		ServicesNs:        "ibm-common-services",
+		CPFSNs:            "ibm-common-services",
+		CatalogSourceName: "opencloud-operators",
+		CatalogSourceNs:   "openshift-marketplace",
+		ApprovalMode:      "Automatic",
+		OnPremMultiEnable: "false",
+	}
+
+	opconYaml, err := constant.ConcatenateConfigs(constant.CSV4OpCon, common.GetBaseOperandConfigList(), data)
+	require.NoError(t, err)
+	opcon := &odlm.OperandConfig{}
+	require.NoError(t, utilyaml.Unmarshal([]byte(opconYaml), opcon))
+	configured := map[string]bool{}
+	for _, service := range opcon.Spec.Services {
+		configured[service.Name] = true
+	}
+
+	registered := map[string]bool{}
+	for _, baseReg := range []string{constant.CSV3OpReg, constant.CSV3SaasOpReg} {
+		opregYaml, err := constant.ConcatenateRegistries(baseReg, common.GetBaseOperandRegistryList(), data, map[string]string{})
+		require.NoError(t, err)
+		opreg := &odlm.OperandRegistry{}
+		require.NoError(t, utilyaml.Unmarshal([]byte(opregYaml), opreg))
+		for _, operator := range opreg.Spec.Operators {
+			registered[operator.Name] = true
+		}
+	}
+
+	for _, arch := range append(Architectures(), "amd64") {
+		for _, profile := range Profiles() {
+			rendered, err := Render(profile, arch)
+			require.NoError(t, err)
+			names, err := ServiceNames(rendered)
+			require.NoError(t, err)
+			for _, name := range names {
+				if externalServices[name] {
+					continue
+				}
+				assert.Truef(t, configured[name], "service %s in %s profile (%s) is not in the OperandConfig", name, profile, arch)
+				assert.Truef(t, registered[name], "service %s in %s profile (%s) is not in the OperandRegistry", name, profile, arch)
+			}
+		}
+	}
+}
@@ -0,0 +1,1989 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package size
+
+// s390xOverlays holds the values of each size profile that differ on s390x
+// from the shared base profile.
+var s390xOverlays = map[string]string{
+	StarterSetProfile: `
+- name: ibm-cert-manager-operator
+  spec:
+    certManager:
+      certManagerCAInjector:
+        resources:
+          requests:
+            cpu: 30m
+            memory: 350Mi
+      certManagerController:
+        resources:
+          limits:
+            cpu: 110m
+          requests:
+            cpu: 70m
+            memory: 390Mi
+      certManagerWebhook:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 90Mi
+- name: ibm-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.0
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.1
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.2
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-iam-operator
+  spec:
+    authentication:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+    oidcclientwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 50Mi
+    pap:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      papService:
+        resources:
+          limits:
+            memory: 330Mi
+          requests:
+            cpu: 50m
+            memory: 160Mi
+    policycontroller:
+      resources:
+        limits:
+          memory: 50Mi
+        requests:
+          memory: 50Mi
+    policydecision:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      resources:
+        requests:
+          cpu: 20m
+          memory: 50Mi
+    secretwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 120Mi
+- name: ibm-im-operator
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.0
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.1
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.2
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.3
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.4
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.5
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.6
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+            ephemeral-storage: 150Mi
+          requests:
+            ephemeral-storage: 50Mi
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-management-ingress-operator
+  spec:
+    managementIngress:
+      resources:
+        requests:
+          cpu: 50m
+          memory: 100Mi
+        limits:
+          cpu: 50m
+- name: ibm-ingress-nginx-operator
+  spec:
+    nginxIngress:
+      ingress:
+        resources:
+          requests:
+            memory: 140Mi
+          limits:
+            cpu: 100m
+      defaultBackend:
+        resources:
+          requests:
+            cpu: 20m
+            memory: 50Mi
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        requests:
+          memory: 220Mi
+        limits:
+          memory: 320Mi
+- name: ibm-commonui-operator
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.0
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.1
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.2
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.3
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.4
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.5
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-platform-api-operator
+  spec:
+    platformApi:
+      auditService:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+      platformApi:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+- name: ibm-healthcheck-operator
+  spec:
+    healthService:
+      healthService:
+        resources:
+          requests:
+            memory: 50Mi
+- name: ibm-auditlogging-operator
+  spec:
+    auditLogging:
+      fluentd:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 100Mi
+          limits:
+            cpu: 35m
+- name: ibm-monitoring-grafana-operator
+  spec:
+    grafana:
+      grafanaConfig:
+        resources:
+          requests:
+            memory: 65Mi
+          limits:
+            memory: 100Mi
+      dashboardConfig:
+        resources:
+          requests:
+            cpu: 5m
+            memory: 50Mi
+      routerConfig:
+        resources:
+          requests:
+            cpu: 10m
+            memory: 50Mi
+`,
+	SmallProfile: `
+- name: ibm-cert-manager-operator
+  spec:
+    certManager:
+      certManagerCAInjector:
+        resources:
+          requests:
+            cpu: 30m
+            memory: 350Mi
+      certManagerController:
+        resources:
+          limits:
+            cpu: 110m
+          requests:
+            cpu: 70m
+            memory: 390Mi
+      certManagerWebhook:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 90Mi
+- name: ibm-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: common-service-postgresql
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          resources:
+            limits:
+              memory: 512Mi
+- name: common-service-cnpg
+  resources:
+    - apiVersion: pg.ibm.com/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          resources:
+            limits:
+              memory: 512Mi
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.0
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.1
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-im-mongodb-operator-v4.2
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 700Mi
+        requests:
+          memory: 700Mi
+- name: ibm-iam-operator
+  spec:
+    authentication:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+    oidcclientwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 50Mi
+    pap:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      papService:
+        resources:
+          limits:
+            memory: 330Mi
+          requests:
+            cpu: 50m
+            memory: 160Mi
+    policycontroller:
+      resources:
+        limits:
+          memory: 50Mi
+        requests:
+          memory: 50Mi
+    policydecision:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      resources:
+        requests:
+          cpu: 20m
+          memory: 50Mi
+    secretwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 120Mi
+- name: ibm-im-operator
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.0
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.1
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.2
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.3
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.4
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.5
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-im-operator-v4.6
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 650Mi
+          requests:
+            cpu: 140m
+            memory: 525Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 220Mi
+          requests:
+            cpu: 50m
+            memory: 120Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 230Mi
+          requests:
+            cpu: 80m
+            memory: 130Mi
+- name: ibm-management-ingress-operator
+  spec:
+    managementIngress:
+      resources:
+        requests:
+          cpu: 50m
+          memory: 100Mi
+        limits:
+          cpu: 50m
+- name: ibm-ingress-nginx-operator
+  spec:
+    nginxIngress:
+      ingress:
+        resources:
+          requests:
+            memory: 140Mi
+          limits:
+            cpu: 100m
+      defaultBackend:
+        resources:
+          requests:
+            cpu: 20m
+            memory: 50Mi
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        requests:
+          memory: 220Mi
+        limits:
+          memory: 320Mi
+- name: ibm-commonui-operator
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.0
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.1
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.2
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.3
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.4
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-idp-config-ui-operator-v4.5
+  spec:
+    commonWebUI:
+      resources:
+        requests:
+          cpu: 150m
+- name: ibm-platform-api-operator
+  spec:
+    platformApi:
+      auditService:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+      platformApi:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+- name: ibm-healthcheck-operator
+  spec:
+    healthService:
+      healthService:
+        resources:
+          requests:
+            memory: 50Mi
+- name: ibm-auditlogging-operator
+  spec:
+    auditLogging:
+      fluentd:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 100Mi
+          limits:
+            cpu: 35m
+- name: ibm-monitoring-grafana-operator
+  spec:
+    grafana:
+      grafanaConfig:
+        resources:
+          requests:
+            memory: 65Mi
+          limits:
+            memory: 100Mi
+      dashboardConfig:
+        resources:
+          requests:
+            cpu: 5m
+            memory: 50Mi
+      routerConfig:
+        resources:
+          requests:
+            cpu: 10m
+            memory: 50Mi
+`,
+	MediumProfile: `
+- name: ibm-cert-manager-operator
+  spec:
+    certManager:
+      certManagerCAInjector:
+        resources:
+          limits:
+            memory: 770Mi
+          requests:
+            cpu: 40m
+            memory: 581Mi
+      certManagerController:
+        resources:
+          limits:
+            cpu: 110m
+            memory: 782Mi
+          requests:
+            cpu: 70m
+            memory: 673Mi
+      certManagerWebhook:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 90Mi
+- name: ibm-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 2048Mi
+        requests:
+          memory: 2048Mi
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 2048Mi
+        requests:
+          memory: 2048Mi
+- name: ibm-im-mongodb-operator-v4.0
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 2048Mi
+        requests:
+          memory: 2048Mi
+- name: ibm-im-mongodb-operator-v4.1
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 2048Mi
+        requests:
+          memory: 2048Mi
+- name: ibm-im-mongodb-operator-v4.2
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 2048Mi
+        requests:
+          memory: 2048Mi
+- name: ibm-iam-operator
+  spec:
+    authentication:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 50m
+            memory: 50Mi
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+    oidcclientwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 67Mi
+    pap:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 50m
+            memory: 50Mi
+      papService:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 195Mi
+    policycontroller:
+      resources:
+        limits:
+          memory: 75Mi
+        requests:
+          memory: 75Mi
+    policydecision:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 20m
+            memory: 50Mi
+      resources:
+        limits:
+          memory: 85Mi
+        requests:
+          cpu: 20m
+          memory: 50Mi
+    secretwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 220Mi
+- name: ibm-im-operator
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.0
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.1
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.2
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.3
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.4
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.5
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-im-operator-v4.6
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 230m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 525Mi
+          requests:
+            cpu: 100m
+            memory: 140Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 355Mi
+          requests:
+            cpu: 320m
+- name: ibm-management-ingress-operator
+  spec:
+    managementIngress:
+      resources:
+        limits:
+          memory: 1024Mi
+        requests:
+          cpu: 200m
+          memory: 256Mi
+- name: ibm-ingress-nginx-operator
+  spec:
+    nginxIngress:
+      defaultBackend:
+        resources:
+          limits:
+            memory: 128Mi
+          requests:
+            cpu: 30m
+            memory: 64Mi
+      ingress:
+        resources:
+          limits:
+            memory: 1024Mi
+          requests:
+            cpu: 200m
+            memory: 256Mi
+      kubectl:
+        resources:
+          limits:
+            cpu: 100m
+            memory: 350Mi
+          requests:
+            cpu: 50m
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        limits:
+          memory: 350Mi
+        requests:
+          memory: 230Mi
+- name: ibm-commonui-operator
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.0
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.1
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.2
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.3
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.4
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-idp-config-ui-operator-v4.5
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 376Mi
+- name: ibm-platform-api-operator
+  spec:
+    platformApi:
+      auditService:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+      platformApi:
+        resources:
+          limits:
+            memory: 100Mi
+          requests:
+            cpu: 25m
+            memory: 67Mi
+- name: ibm-auditlogging-operator
+  spec:
+    auditLogging:
+      fluentd:
+        resources:
+          limits:
+            cpu: 50m
+          requests:
+            memory: 128Mi
+- name: ibm-monitoring-grafana-operator
+  spec:
+    grafana:
+      dashboardConfig:
+        resources:
+          limits:
+            memory: 123Mi
+          requests:
+            memory: 93Mi
+      grafanaConfig:
+        resources:
+          limits:
+            memory: 148Mi
+          requests:
+            cpu: 25m
+            memory: 87Mi
+      routerConfig:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 65Mi
+`,
+	LargeProfile: `
+- name: ibm-cert-manager-operator
+  spec:
+    certManager:
+      certManagerCAInjector:
+        resources:
+          limits:
+            memory: 770Mi
+          requests:
+            cpu: 40m
+            memory: 581Mi
+      certManagerController:
+        resources:
+          limits:
+            cpu: 110m
+            memory: 782Mi
+          requests:
+            cpu: 70m
+            memory: 673Mi
+      certManagerWebhook:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 90Mi
+- name: ibm-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 3072Mi
+        requests:
+          memory: 3072Mi
+- name: common-service-postgresql
+  resources:
+    - apiVersion: postgresql.k8s.enterprisedb.io/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          resources:
+            limits:
+              cpu: 1500m
+              memory: 3072Mi
+              ephemeral-storage: 1024Mi
+            requests:
+              ephemeral-storage: 500Mi
+              cpu: 384m
+              memory: 768Mi
+- name: common-service-cnpg
+  resources:
+    - apiVersion: pg.ibm.com/v1
+      kind: Cluster
+      name: common-service-db
+      data:
+        spec:
+          resources:
+            limits:
+              cpu: 1500m
+              memory: 3072Mi
+              ephemeral-storage: 1024Mi
+            requests:
+              ephemeral-storage: 500Mi
+              cpu: 384m
+              memory: 768Mi
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 3072Mi
+        requests:
+          memory: 3072Mi
+- name: ibm-im-mongodb-operator-v4.0
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 3072Mi
+        requests:
+          memory: 3072Mi
+- name: ibm-im-mongodb-operator-v4.1
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 3072Mi
+        requests:
+          memory: 3072Mi
+- name: ibm-im-mongodb-operator-v4.2
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 3072Mi
+        requests:
+          memory: 3072Mi
+- name: ibm-iam-operator
+  spec:
+    authentication:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 75m
+            memory: 50Mi
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+    oidcclientwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 67Mi
+    pap:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 50Mi
+          requests:
+            cpu: 75m
+            memory: 50Mi
+      papService:
+        resources:
+          requests:
+            cpu: 50m
+            memory: 195Mi
+    policycontroller:
+      resources:
+        limits:
+          memory: 75Mi
+        requests:
+          memory: 75Mi
+    policydecision:
+      auditService:
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 60Mi
+          requests:
+            cpu: 30m
+            memory: 50Mi
+      resources:
+        requests:
+          cpu: 195m
+          memory: 270Mi
+    secretwatcher:
+      resources:
+        requests:
+          cpu: 30m
+          memory: 220Mi
+- name: ibm-im-operator
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.0
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.1
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.2
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.3
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.4
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.5
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-im-operator-v4.6
+  spec:
+    authentication:
+      authService:
+        resources:
+          limits:
+            memory: 745Mi
+          requests:
+            cpu: 725m
+            memory: 695Mi
+      identityManager:
+        resources:
+          limits:
+            memory: 530Mi
+          requests:
+            cpu: 340m
+            memory: 385Mi
+      identityProvider:
+        resources:
+          limits:
+            memory: 480Mi
+          requests:
+            cpu: 410m
+            memory: 335Mi
+- name: ibm-management-ingress-operator
+  spec:
+    managementIngress:
+      resources:
+        limits:
+          memory: 1288Mi
+        requests:
+          cpu: 200m
+          memory: 452Mi
+- name: ibm-ingress-nginx-operator
+  spec:
+    nginxIngress:
+      defaultBackend:
+        resources:
+          limits:
+            memory: 156Mi
+          requests:
+            cpu: 30m
+            memory: 116Mi
+      ingress:
+        resources:
+          limits:
+            memory: 1188Mi
+          requests:
+            cpu: 200m
+            memory: 512Mi
+      kubectl:
+        resources:
+          limits:
+            cpu: 150m
+            memory: 495Mi
+          requests:
+            cpu: 50m
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        limits:
+          memory: 409Mi
+        requests:
+          memory: 270Mi
+- name: ibm-commonui-operator
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.0
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.1
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.2
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.3
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.4
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-idp-config-ui-operator-v4.5
+  spec:
+    commonWebUI:
+      resources:
+        limits:
+          memory: 430Mi
+        requests:
+          cpu: 300m
+          memory: 384Mi
+- name: ibm-platform-api-operator
+  spec:
+    platformApi:
+      auditService:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 50Mi
+      platformApi:
+        resources:
+          limits:
+            memory: 100Mi
+          requests:
+            cpu: 25m
+            memory: 67Mi
+- name: ibm-healthcheck-operator
+  spec:
+    healthService:
+      healthService:
+        resources:
+          requests:
+            cpu: 27m
+            memory: 153Mi
+- name: ibm-auditlogging-operator
+  spec:
+    auditLogging:
+      fluentd:
+        resources:
+          limits:
+            cpu: 75m
+            memory: 300Mi
+          requests:
+            cpu: 59m
+            memory: 231Mi
+- name: ibm-monitoring-grafana-operator
+  spec:
+    grafana:
+      dashboardConfig:
+        resources:
+          limits:
+            memory: 145Mi
+      grafanaConfig:
+        resources:
+          requests:
+            cpu: 30m
+            memory: 195Mi
+      routerConfig:
+        resources:
+          requests:
+            cpu: 25m
+            memory: 65Mi
+`,
+}
@@ -16,7 +16,8 @@
 
 package size
 
-const Small = `
+// smallBase is the small profile before any architecture overlay is applied.
+const smallBase = `
 - name: ibm-cert-manager-operator
   spec:
     certManager:
@@ -16,7 +16,8 @@
 
 package size
 
-const StarterSet = `
+// starterSetBase is the starterset profile before any architecture overlay is applied.
+const starterSetBase = `
 - name: ibm-cert-manager-operator
   spec:
     certManager:
@@ -0,0 +1,253 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// replicaKeys are the keys of the size profiles holding a replica count,
+// instances is used by the EDB Cluster resources
+var replicaKeys = map[string]bool{
+	"replicas":  true,
+	"instances": true,
+}
+
+// factor is a parsed scaling factor and the requirements it applies to
+type factor struct {
+	value  resource.Quantity
+	target string
+}
+
+// resolvedFactors are the factors that apply to a service component, a nil
+// factor leaves the value unchanged
+type resolvedFactors struct {
+	cpu      *factor
+	memory   *factor
+	replicas *factor
+}
+
+// parsedFactors are the ScalingFactors of a SizeFactors entry after parsing
+type parsedFactors struct {
+	service   string
+	component string
+	factors   resolvedFactors
+}
+
+// applySizeFactors multiplies the CPU, memory, and replicas of the size
+// profile by the factors set in the CommonService CR. The factors of a service
+// component take precedence over the factors of the service, which take
+// precedence over the global factors.
+func applySizeFactors(sizes []interface{}, sizeFactors *apiv3.SizeFactors) error {
+	if sizeFactors == nil {
+		return nil
+	}
+
+	global, entries, err := parseSizeFactors(sizeFactors)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range sizes {
+		service, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := service["name"].(string)
+
+		if spec, ok := service["spec"].(map[string]interface{}); ok {
+			for component, config := range spec {
+				factors := resolveSizeFactors(global, entries, name, component)
+				if err := scaleSizeConfig(config, factors); err != nil {
+					return fmt.Errorf("failed to scale %s of service %s: %v", component, name, err)
+				}
+			}
+		}
+		if resources, ok := service["resources"].([]interface{}); ok {
+			for _, res := range resources {
+				resMap, ok := res.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				component, _ := resMap["name"].(string)
+				factors := resolveSizeFactors(global, entries, name, component)
+				if err := scaleSizeConfig(resMap["data"], factors); err != nil {
+					return fmt.Errorf("failed to scale resource %s of service %s: %v", component, name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSizeFactors checks that the size factors are positive decimals
+func ValidateSizeFactors(sizeFactors *apiv3.SizeFactors) error {
+	if sizeFactors == nil {
+		return nil
+	}
+	_, _, err := parseSizeFactors(sizeFactors)
+	return err
+}
+
+func parseSizeFactors(sizeFactors *apiv3.SizeFactors) (resolvedFactors, []parsedFactors, error) {
+	global, err := parseScalingFactors(sizeFactors.ScalingFactors)
+	if err != nil {
+		return global, nil, fmt.Errorf("invalid global size factors: %v", err)
+	}
+	var entries []parsedFactors
+	for _, service := range sizeFactors.Services {
+		if service.Name == "" {
+			return global, nil, fmt.Errorf("size factors must set the name of the service")
+		}
+		factors, err := parseScalingFactors(service.ScalingFactors)
+		if err != nil {
+			return global, nil, fmt.Errorf("invalid size factors for service %s: %v", service.Name, err)
+		}
+		entries = append(entries, parsedFactors{service: service.Name, component: service.Component, factors: factors})
+	}
+	return global, entries, nil
+}
+
+// parseScalingFactors parses the factors that are set
+func parseScalingFactors(scaling apiv3.ScalingFactors) (resolvedFactors, error) {
+	var factors resolvedFactors
+	for _, field := range []struct {
+		value  string
+		target string
+		into   **factor
+	}{
+		{scaling.CPU, scaling.Target, &factors.cpu},
+		{scaling.Memory, scaling.Target, &factors.memory},
+		{scaling.Replicas, "", &factors.replicas},
+	} {
+		if field.value == "" {
+			continue
+		}
+		value, err := rules.ParseFactor(field.value)
+		if err != nil {
+			return factors, err
+		}
+		*field.into = &factor{value: value, target: field.target}
+	}
+	return factors, nil
+}
+
+// resolveSizeFactors picks each factor from the most specific entry that sets it
+func resolveSizeFactors(global resolvedFactors, entries []parsedFactors, service, component string) resolvedFactors {
+	resolved := global
+	for _, componentOnly := range []bool{false, true} {
+		for _, entry := range entries {
+			if entry.service != service {
+				continue
+			}
+			if componentOnly && entry.component != component || !componentOnly && entry.component != "" {
+				continue
+			}
+			if entry.factors.cpu != nil {
+				resolved.cpu = entry.factors.cpu
+			}
+			if entry.factors.memory != nil {
+				resolved.memory = entry.factors.memory
+			}
+			if entry.factors.replicas != nil {
+				resolved.replicas = entry.factors.replicas
+			}
+		}
+	}
+	return resolved
+}
+
+// scaleSizeConfig walks a size configuration and scales the container
+// resources and replica counts in place
+func scaleSizeConfig(config interface{}, factors resolvedFactors) error {
+	switch config := config.(type) {
+	case map[string]interface{}:
+		for key, value := range config {
+			if replicaKeys[key] && factors.replicas != nil {
+				if _, ok := value.(map[string]interface{}); !ok {
+					replicas, err := rules.ScaleReplicas(value, factors.replicas.value)
+					if err != nil {
+						return err
+					}
+					config[key] = replicas
+					continue
+				}
+			}
+			if key == "resources" && isResourceRequirements(value) {
+				if err := scaleResourceRequirements(value.(map[string]interface{}), factors); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := scaleSizeConfig(value, factors); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range config {
+			if err := scaleSizeConfig(value, factors); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isResourceRequirements reports whether the value is a container resources
+// block with requests or limits
+func isResourceRequirements(value interface{}) bool {
+	requirements, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, requests := requirements["requests"]
+	_, limits := requirements["limits"]
+	return requests || limits
+}
+
+func scaleResourceRequirements(requirements map[string]interface{}, factors resolvedFactors) error {
+	for _, target := range []string{"requests", "limits"} {
+		list, ok := requirements[target].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, scaling := range []struct {
+			name   string
+			factor *factor
+			scale  resource.Scale
+		}{
+			{"cpu", factors.cpu, resource.Milli},
+			{"memory", factors.memory, 0},
+		} {
+			value, ok := list[scaling.name]
+			if !ok || scaling.factor == nil || (scaling.factor.target != "" && scaling.factor.target != target) {
+				continue
+			}
+			scaled, err := rules.ScaleQuantity(value, scaling.factor.value, scaling.scale)
+			if err != nil {
+				return err
+			}
+			list[scaling.name] = scaled
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+const sizeFactorsTemplate = `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 3
+      resources:
+        requests:
+          cpu: 1
+          memory: 1Gi
+    oidcclientwatcher:
+      resources:
+        requests:
+          cpu: 100m
+          memory: 100Mi
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: 2
+        resources:
+          limits:
+            cpu: 200m
+            memory: 768Mi
+`
+
+func TestApplySizeFactors_Precedence(t *testing.T) {
+	sizes, err := convertStringToSlice(sizeFactorsTemplate)
+	require.NoError(t, err)
+
+	require.NoError(t, applySizeFactors(sizes, &apiv3.SizeFactors{
+		ScalingFactors: apiv3.ScalingFactors{CPU: "2", Memory: "0.5", Replicas: "0.5"},
+		Services: []apiv3.ServiceSizeFactors{
+			{Name: "ibm-im-operator", ScalingFactors: apiv3.ScalingFactors{CPU: "1.5"}},
+			{Name: "ibm-im-operator", Component: "authentication", ScalingFactors: apiv3.ScalingFactors{Memory: "2"}},
+			{Name: "common-service-postgresql", Component: "common-service-db", ScalingFactors: apiv3.ScalingFactors{Replicas: "2"}},
+		},
+	}))
+
+	im := sizes[0].(map[string]interface{})["spec"].(map[string]interface{})
+	authentication := im["authentication"].(map[string]interface{})
+	assert.EqualValues(t, 2, authentication["replicas"], "replicas are rounded up")
+	assert.Equal(t, "1500m", authentication["resources"].(map[string]interface{})["requests"].(map[string]interface{})["cpu"])
+	assert.Equal(t, "2Gi", authentication["resources"].(map[string]interface{})["requests"].(map[string]interface{})["memory"])
+
+	watcher := im["oidcclientwatcher"].(map[string]interface{})["resources"].(map[string]interface{})["requests"].(map[string]interface{})
+	assert.Equal(t, "150m", watcher["cpu"])
+	assert.Equal(t, "50Mi", watcher["memory"])
+
+	db := sizes[1].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.EqualValues(t, 4, db["instances"])
+	assert.Equal(t, "400m", db["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"])
+	assert.Equal(t, "384Mi", db["resources"].(map[string]interface{})["limits"].(map[string]interface{})["memory"])
+}
+
+func TestApplySizeFactors_Target(t *testing.T) {
+	sizes, err := convertStringToSlice(sizeFactorsTemplate)
+	require.NoError(t, err)
+
+	require.NoError(t, applySizeFactors(sizes, &apiv3.SizeFactors{
+		ScalingFactors: apiv3.ScalingFactors{CPU: "2", Target: "limits"},
+	}))
+
+	im := sizes[0].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.EqualValues(t, 1, im["authentication"].(map[string]interface{})["resources"].(map[string]interface{})["requests"].(map[string]interface{})["cpu"], "requests must not be scaled")
+	db := sizes[1].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})
+	assert.Equal(t, "400m", db["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"])
+}
+
+func TestApplySizeFactors_Invalid(t *testing.T) {
+	sizes, err := convertStringToSlice(sizeFactorsTemplate)
+	require.NoError(t, err)
+
+	assert.Error(t, applySizeFactors(sizes, &apiv3.SizeFactors{ScalingFactors: apiv3.ScalingFactors{CPU: "0"}}))
+	assert.Error(t, applySizeFactors(sizes, &apiv3.SizeFactors{Services: []apiv3.ServiceSizeFactors{
+		{Name: "ibm-im-operator", ScalingFactors: apiv3.ScalingFactors{Memory: "double"}},
+	}}))
+	assert.NoError(t, applySizeFactors(sizes, nil))
+}
@@ -0,0 +1,269 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// sizingValidator checks the container resources and replicas of the
+// OperandConfig services, and corrects them when fix is set
+type sizingValidator struct {
+	fix bool
+	// allocatable is the largest node allocatable of each resource, the
+	// node checks are skipped when it is nil
+	allocatable corev1.ResourceList
+	issues      []string
+}
+
+// validateSizing checks that the quantities are parseable and not negative,
+// that the requests are not larger than the limits, that the replicas are not
+// negative, and that ephemeral-storage and hugepages fit on a node. With fix,
+// the invalid values are corrected in place. It returns a description of each
+// issue found.
+func validateSizing(services []interface{}, fix bool, allocatable corev1.ResourceList) []string {
+	v := &sizingValidator{fix: fix, allocatable: allocatable}
+	for _, item := range services {
+		service, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := service["name"].(string)
+		for _, key := range []string{"spec", "resources"} {
+			if config, ok := service[key]; ok {
+				v.walk(name+"."+key, config)
+			}
+		}
+	}
+	return v.issues
+}
+
+func (v *sizingValidator) report(issue, fix string) {
+	if v.fix {
+		issue = issue + ", " + fix
+	}
+	v.issues = append(v.issues, issue)
+}
+
+func (v *sizingValidator) walk(path string, config interface{}) {
+	switch config := config.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(config) {
+			value := config[key]
+			if replicaKeys[key] && v.checkReplicas(path+"."+key, config, key) {
+				continue
+			}
+			if key == "resources" && isResourceRequirements(value) {
+				v.checkRequirements(path+"."+key, value.(map[string]interface{}))
+				continue
+			}
+			v.walk(path+"."+key, value)
+		}
+	case []interface{}:
+		for i, value := range config {
+			v.walk(fmt.Sprintf("%s[%d]", path, i), value)
+		}
+	}
+}
+
+// checkReplicas reports a negative replica count, it returns false when the
+// value is not a number
+func (v *sizingValidator) checkReplicas(path string, config map[string]interface{}, key string) bool {
+	var replicas float64
+	switch value := config[key].(type) {
+	case int:
+		replicas = float64(value)
+	case int64:
+		replicas = float64(value)
+	case float64:
+		replicas = value
+	default:
+		return false
+	}
+	if replicas < 0 {
+		v.report(fmt.Sprintf("%s is negative: %v", path, config[key]), "removed to use the default")
+		if v.fix {
+			delete(config, key)
+		}
+	}
+	return true
+}
+
+func (v *sizingValidator) checkRequirements(path string, requirements map[string]interface{}) {
+	requests, _ := requirements["requests"].(map[string]interface{})
+	limits, _ := requirements["limits"].(map[string]interface{})
+
+	// quantities
+	for _, target := range []struct {
+		name string
+		list map[string]interface{}
+	}{{"requests", requests}, {"limits", limits}} {
+		for _, name := range sortedKeys(target.list) {
+			value := target.list[name]
+			if _, ok := value.(map[string]interface{}); ok {
+				// the value is resolved by ODLM, e.g. templatingValueFrom
+				continue
+			}
+			quantity, err := rules.ParseResourceQuantity(value)
+			if err == nil && quantity.Sign() >= 0 {
+				continue
+			}
+			v.report(fmt.Sprintf("%s.%s.%s is not a valid quantity: %v", path, target.name, name, value), "removed to use the default")
+			if v.fix {
+				delete(target.list, name)
+			}
+		}
+	}
+
+	// requests <= limits
+	for _, name := range sortedKeys(requests) {
+		request, err := rules.ParseResourceQuantity(requests[name])
+		if err != nil {
+			continue
+		}
+		limitValue, ok := limits[name]
+		if !ok {
+			continue
+		}
+		limit, err := rules.ParseResourceQuantity(limitValue)
+		if err != nil || request.Cmp(limit) <= 0 {
+			continue
+		}
+		v.report(fmt.Sprintf("%s: %s request %v is larger than the limit %v", path, name, requests[name], limitValue), "the limit is raised to the request")
+		if v.fix {
+			limits[name] = requests[name]
+		}
+	}
+
+	// node allocatable
+	if v.allocatable == nil {
+		return
+	}
+	for _, name := range sortedKeys(requests, limits) {
+		if name != string(corev1.ResourceEphemeralStorage) && !strings.HasPrefix(name, corev1.ResourceHugePagesPrefix) {
+			continue
+		}
+		allocatable := v.allocatable[corev1.ResourceName(name)]
+		for _, list := range []map[string]interface{}{requests, limits} {
+			quantity, err := rules.ParseResourceQuantity(list[name])
+			if _, ok := list[name]; !ok || err != nil || quantity.Cmp(allocatable) <= 0 {
+				continue
+			}
+			if allocatable.IsZero() {
+				v.report(fmt.Sprintf("%s: %s %v is not allocatable on any node", path, name, list[name]), "removed")
+				if v.fix {
+					delete(requests, name)
+					delete(limits, name)
+				}
+				break
+			}
+			v.report(fmt.Sprintf("%s: %s %v is larger than the largest node allocatable %s", path, name, list[name], allocatable.String()), "lowered to the node allocatable")
+			if v.fix {
+				list[name] = allocatable.String()
+			}
+		}
+	}
+}
+
+// sortedKeys returns the keys of the maps in order, so that the issues are
+// reported in the same order on every reconciliation
+func sortedKeys(maps ...map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getMasterCommonService returns the CommonService CR of the operator
+// namespace, it returns nil when the CR is not found
+func (r *CommonServiceReconciler) getMasterCommonService(ctx context.Context) (*apiv3.CommonService, error) {
+	masterCR := &apiv3.CommonService{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: r.Bootstrap.CSData.OperatorNs}, masterCR); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return masterCR, nil
+}
+
+// getSizeValidationPolicy returns the size validation policy of the master
+// CommonService CR, AutoFix by default
+func (r *CommonServiceReconciler) getSizeValidationPolicy(ctx context.Context) (string, error) {
+	masterCR, err := r.getMasterCommonService(ctx)
+	if err != nil {
+		return "", err
+	}
+	if masterCR == nil || masterCR.Spec.SizeValidation == "" {
+		return apiv3.SizeValidationAutoFix, nil
+	}
+	return masterCR.Spec.SizeValidation, nil
+}
+
+// validateMergedSizing validates the sizing of the merged services according
+// to the size validation policy. It returns an error when the policy is Reject
+// and the sizing is invalid.
+func (r *CommonServiceReconciler) validateMergedSizing(ctx context.Context, services []interface{}) error {
+	policy, err := r.getSizeValidationPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	// the operands also run on the tainted nodes tolerated by their placement
+	var placement *apiv3.Placement
+	if masterCR, err := r.getMasterCommonService(ctx); err != nil {
+		return err
+	} else if masterCR != nil {
+		placement = masterCR.Spec.Placement
+	}
+	allocatable, ok := r.Bootstrap.MaxNodeAllocatable(ctx, placement)
+	if !ok {
+		allocatable = nil
+	}
+
+	issues := validateSizing(services, policy != apiv3.SizeValidationReject, allocatable)
+	r.Bootstrap.SetSizingIssues(issues)
+	if len(issues) == 0 {
+		return nil
+	}
+	for _, issue := range issues {
+		klog.Warningf("Invalid sizing in OperandConfig: %s", issue)
+	}
+	if policy == apiv3.SizeValidationReject {
+		return fmt.Errorf("the sizing is rejected by the %s size validation policy: %s", policy, strings.Join(issues, "; "))
+	}
+	return nil
+}
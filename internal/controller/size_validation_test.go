@@ -0,0 +1,116 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+)
+
+const invalidSizing = `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: -1
+      resources:
+        requests:
+          cpu: 500m
+          memory: 1Gi
+          ephemeral-storage: 512Mi
+        limits:
+          cpu: 200m
+          memory: lots
+          ephemeral-storage: 1Gi
+          hugepages-2Mi: 64Mi
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: 2
+        resources:
+          requests:
+            cpu: 100m
+          limits:
+            cpu: 200m
+`
+
+func TestValidateSizing_AutoFix(t *testing.T) {
+	services, err := convertStringToSlice(invalidSizing)
+	require.NoError(t, err)
+
+	issues := validateSizing(services, true, nil)
+	assert.Len(t, issues, 3)
+
+	authentication := services[0].(map[string]interface{})["spec"].(map[string]interface{})["authentication"].(map[string]interface{})
+	assert.NotContains(t, authentication, "replicas", "a negative replica count is removed")
+	limits := authentication["resources"].(map[string]interface{})["limits"].(map[string]interface{})
+	assert.Equal(t, "500m", limits["cpu"], "the limit is raised to the request")
+	assert.NotContains(t, limits, "memory", "an invalid quantity is removed")
+
+	assert.Empty(t, validateSizing(services, true, nil), "the fixed sizing must be valid")
+}
+
+func TestValidateSizing_Reject(t *testing.T) {
+	services, err := convertStringToSlice(invalidSizing)
+	require.NoError(t, err)
+
+	issues := validateSizing(services, false, nil)
+	assert.Len(t, issues, 3)
+
+	authentication := services[0].(map[string]interface{})["spec"].(map[string]interface{})["authentication"].(map[string]interface{})
+	assert.EqualValues(t, -1, authentication["replicas"], "the sizing must not be changed")
+	assert.Equal(t, "200m", authentication["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"])
+}
+
+func TestValidateSizing_NodeAllocatable(t *testing.T) {
+	services, err := convertStringToSlice(invalidSizing)
+	require.NoError(t, err)
+
+	allocatable := corev1.ResourceList{
+		corev1.ResourceEphemeralStorage: resource.MustParse("768Mi"),
+	}
+	issues := validateSizing(services, true, allocatable)
+	assert.Len(t, issues, 5)
+
+	resources := services[0].(map[string]interface{})["spec"].(map[string]interface{})["authentication"].(map[string]interface{})["resources"].(map[string]interface{})
+	assert.Equal(t, "512Mi", resources["requests"].(map[string]interface{})["ephemeral-storage"])
+	assert.Equal(t, "768Mi", resources["limits"].(map[string]interface{})["ephemeral-storage"])
+	assert.NotContains(t, resources["limits"], "hugepages-2Mi", "hugepages no node has are removed")
+
+	assert.Empty(t, validateSizing(services, true, allocatable))
+}
+
+func TestValidateSizing_BuiltinProfiles(t *testing.T) {
+	for _, profile := range size.Profiles() {
+		for _, arch := range append(size.Architectures(), "amd64") {
+			template, err := size.Render(profile, arch)
+			require.NoError(t, err)
+			services, err := convertStringToSlice(template)
+			require.NoError(t, err)
+			assert.Empty(t, validateSizing(services, false, nil), "size profile %s on %s", profile, arch)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// renderTLSProfile renders the TLS security profile template. The PostgreSQL
+// ciphers are left out when the profile only has TLS 1.3 cipher suites.
+func renderTLSProfile(profile *apiv3.TLSSecurityProfile) ([]interface{}, error) {
+	if profile == nil {
+		return nil, nil
+	}
+	spec, err := util.ResolveTLSProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	services, err := convertStringToSlice(constant.TLSProfileTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{
+		constant.PlaceholderTLSMinVersion:      spec.PostgresMinTLSVersion(),
+		constant.PlaceholderKeycloakTLSOptions: keycloakTLSOptions(spec),
+	}
+	if ciphers := spec.PostgresCiphers(); ciphers != "" {
+		values[constant.PlaceholderTLSCiphers] = ciphers
+	}
+	for _, item := range services {
+		if service, ok := item.(map[string]interface{}); ok {
+			replacePlaceholders(service["resources"], values)
+		}
+	}
+	return services, nil
+}
+
+// keycloakTLSOptions returns the additional options of Keycloak for the TLS
+// protocols and cipher suites, the cipher suites are left out when none of the
+// ciphers has an IANA name
+func keycloakTLSOptions(spec util.TLSProfileSpec) []interface{} {
+	options := []interface{}{
+		map[string]interface{}{"name": "https-protocols", "value": spec.KeycloakProtocols()},
+	}
+	if ciphers := spec.KeycloakCipherSuites(); ciphers != "" {
+		options = append(options, map[string]interface{}{"name": "https-cipher-suites", "value": ciphers})
+	}
+	return options
+}
@@ -67,10 +67,13 @@ func (r *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 		return admission.Errored(http.StatusBadRequest, operatorNsErr)
 	}
 
-	catalogSourceName, catalogSourceNs := util.GetCatalogSource(constant.IBMCSPackage, operatorNs, r.Reader)
-	if catalogSourceName == "" || catalogSourceNs == "" {
-		err := fmt.Errorf("failed to get catalogsource")
-		return admission.Errored(http.StatusBadRequest, err)
+	// the CatalogSource is only used when the operator is installed by OLM
+	if util.GetInstallMode() == constant.InstallModeOLM {
+		catalogSourceName, catalogSourceNs := util.GetCatalogSource(constant.IBMCSPackage, operatorNs, r.Reader)
+		if catalogSourceName == "" || catalogSourceNs == "" {
+			err := fmt.Errorf("failed to get catalogsource")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
 	}
 
 	// handle the request from CommonService
@@ -139,6 +142,49 @@ func (r *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 		return admission.Denied(fmt.Sprintf("HugePageSetting is invalid: %v", err))
 	}
 
+	// check SizeFactors
+	if err := controller.ValidateSizeFactors(cs.Spec.SizeFactors); err != nil {
+		return admission.Denied(fmt.Sprintf("SizeFactors is invalid: %v", err))
+	}
+
+	// check Storage
+	if err := controller.ValidateServiceStorage(cs.Spec.Storage); err != nil {
+		return admission.Denied(fmt.Sprintf("Storage is invalid: %v", err))
+	}
+
+	// check Backup
+	if err := controller.ValidateBackup(cs.Spec.Backup); err != nil {
+		return admission.Denied(fmt.Sprintf("Backup is invalid: %v", err))
+	}
+
+	// check Restore, a replica is recreated from its primary instead
+	if err := controller.ValidateRestore(cs.Spec.Restore); err != nil {
+		return admission.Denied(fmt.Sprintf("Restore is invalid: %v", err))
+	}
+	if cs.Spec.Restore != nil && cs.Spec.CSPostgreSQLReplica != nil {
+		return admission.Denied("Restore is invalid: common-service-db can't be restored when csPostgreSQLReplica is set")
+	}
+
+	// check Proxy
+	if err := util.ValidateProxy(cs.Spec.Proxy); err != nil {
+		return admission.Denied(fmt.Sprintf("Proxy is invalid: %v", err))
+	}
+
+	// check ImageRegistry
+	if err := util.ValidateImageRegistry(cs.Spec.ImageRegistry); err != nil {
+		return admission.Denied(fmt.Sprintf("ImageRegistry is invalid: %v", err))
+	}
+
+	// check TLSSecurityProfile
+	if err := util.ValidateTLSSecurityProfile(cs.Spec.TLSSecurityProfile); err != nil {
+		return admission.Denied(fmt.Sprintf("TLSSecurityProfile is invalid: %v", err))
+	}
+
+	// check InstallPlanApprovalPolicy
+	if err := util.ValidateInstallPlanApprovalPolicy(cs.Spec.InstallPlanApprovalPolicy, cs.Spec.InstallPlanApproval); err != nil {
+		return admission.Denied(fmt.Sprintf("InstallPlanApprovalPolicy is invalid: %v", err))
+	}
+
 	// Validate replica configuration against existing OperandConfig.
 	// Skip for non-configurable CRs: these are copies of the master CR that the reconciler
 	// pushes to other watch namespaces (same name "common-service", different namespace).
@@ -19,6 +19,7 @@ package operandrequest
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -31,7 +32,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
 	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
 	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
 )
 
@@ -61,6 +64,9 @@ func (r *Defaulter) Handle(ctx context.Context, req admission.Request) admission
 
 	if !r.IsDormant {
 		r.Default(copy)
+		if msg := r.disabledOperands(ctx, req, copy); msg != "" {
+			return admission.Denied(msg)
+		}
 	}
 
 	marshaledCopy, err := json.Marshal(copy)
@@ -110,6 +116,76 @@ func (r *Defaulter) Default(instance *odlm.OperandRequest) {
 	}
 }
 
+// disabledOperands returns why the OperandRequest is rejected when it newly
+// requests an operand whose service is disabled in the CommonService CR.
+// The check is best effort: the webhook ignores failures, and the request is
+// admitted when the CommonService CR can't be read. The no-op install mode
+// of the disabled operators in the OperandRegistry is the enforcement.
+func (r *Defaulter) disabledOperands(ctx context.Context, req admission.Request, instance *odlm.OperandRequest) string {
+	if instance.DeletionTimestamp != nil {
+		return ""
+	}
+	operatorNs, err := util.GetOperatorNamespace()
+	if err != nil {
+		klog.Warningf("Failed to get the operator namespace, skipping the disabled services check of OperandRequest %s/%s: %v", instance.Namespace, instance.Name, err)
+		return ""
+	}
+	cs := &apiv3.CommonService{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Name: constant.MasterCR, Namespace: operatorNs}, cs); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.Warningf("Failed to get CommonService %s/%s, admitting OperandRequest %s/%s without the disabled services check: %v", operatorNs, constant.MasterCR, instance.Namespace, instance.Name, err)
+		}
+		return ""
+	}
+	if !hasDisabledService(cs.Spec.Services) {
+		return ""
+	}
+
+	// the operands already requested are not rejected on update
+	requested := map[string]bool{}
+	if len(req.OldObject.Raw) > 0 {
+		old := &odlm.OperandRequest{}
+		if err := r.decoder.DecodeRaw(req.OldObject, old); err == nil {
+			for _, request := range old.Spec.Requests {
+				for _, operand := range request.Operands {
+					requested[operand.Name] = true
+				}
+			}
+		}
+	}
+
+	servicesNs := util.GetServicesNamespace(r.Reader)
+	var disabled []string
+	for _, request := range instance.Spec.Requests {
+		registryNs := request.RegistryNamespace
+		if registryNs == "" {
+			registryNs = instance.Namespace
+		}
+		if request.Registry != constant.MasterCR || registryNs != servicesNs {
+			continue
+		}
+		for _, operand := range request.Operands {
+			if !requested[operand.Name] && apiv3.ServiceDisabled(cs.Spec.Services, operand.Name) != nil {
+				disabled = append(disabled, operand.Name)
+			}
+		}
+	}
+	if len(disabled) == 0 {
+		return ""
+	}
+	klog.Infof("Rejecting OperandRequest %s/%s, it requests disabled operands %v", instance.Namespace, instance.Name, disabled)
+	return fmt.Sprintf("operands %s are disabled by .spec.services[].enabled of CommonService %s/%s", strings.Join(disabled, ", "), operatorNs, constant.MasterCR)
+}
+
+func hasDisabledService(services []apiv3.ServiceConfig) bool {
+	for _, service := range services {
+		if service.Disabled() {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Defaulter) InjectDecoder(decoder admission.Decoder) error {
 	r.decoder = decoder
 	return nil
@@ -0,0 +1,94 @@
+//
+// Copyright 2022 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package operandrequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+)
+
+func newOperandRequest(operands ...string) *odlm.OperandRequest {
+	request := odlm.Request{Registry: constant.MasterCR, RegistryNamespace: "cs-operator"}
+	for _, operand := range operands {
+		request.Operands = append(request.Operands, odlm.Operand{Name: operand})
+	}
+	return &odlm.OperandRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "request", Namespace: "cp4x"},
+		Spec:       odlm.OperandRequestSpec{Requests: []odlm.Request{request}},
+	}
+}
+
+func TestDisabledOperands(t *testing.T) {
+	t.Setenv(constant.OperatorNamespaceEnvVar, "cs-operator")
+
+	scheme := runtime.NewScheme()
+	_ = apiv3.AddToScheme(scheme)
+	_ = odlm.AddToScheme(scheme)
+	cs := &apiv3.CommonService{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.MasterCR, Namespace: "cs-operator"},
+		Spec: apiv3.CommonServiceSpec{
+			Services: []apiv3.ServiceConfig{
+				{Name: "ibm-im-operator", Enabled: pointer.Bool(false)},
+				{Name: "ibm-platformui-operator", Enabled: pointer.Bool(true)},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cs).Build()
+	r := &Defaulter{Reader: fakeClient, Client: fakeClient, decoder: admission.NewDecoder(scheme)}
+	ctx := context.Background()
+
+	// a new request of a disabled operand is rejected
+	msg := r.disabledOperands(ctx, admission.Request{}, newOperandRequest("ibm-im-operator-v4.2", "ibm-platformui-operator"))
+	assert.Contains(t, msg, "operands ibm-im-operator-v4.2 are disabled")
+
+	// an operand already requested is not rejected on update
+	old, err := json.Marshal(newOperandRequest("ibm-im-operator-v4.2"))
+	assert.NoError(t, err)
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{OldObject: runtime.RawExtension{Raw: old}}}
+	msg = r.disabledOperands(ctx, req, newOperandRequest("ibm-im-operator-v4.2", "ibm-platformui-operator"))
+	assert.Empty(t, msg)
+
+	// the requests of other registries are not rejected
+	opreq := newOperandRequest("ibm-im-operator")
+	opreq.Spec.Requests[0].Registry = "other-registry"
+	assert.Empty(t, r.disabledOperands(ctx, admission.Request{}, opreq))
+
+	// the request is admitted when the CommonService CR can't be read
+	failing := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cs).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			return fmt.Errorf("connection refused")
+		},
+	}).Build()
+	r = &Defaulter{Reader: failing, Client: failing, decoder: admission.NewDecoder(scheme)}
+	assert.Empty(t, r.disabledOperands(ctx, admission.Request{}, newOperandRequest("ibm-im-operator")))
+}
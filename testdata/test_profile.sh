@@ -28,8 +28,8 @@ test_profile() {
 
     echo "testing with file: $size_file"
 
-    # keys to remove from controllers/size files before comparison
-    # necessary because size files in controllers/size missing many keys that
+    # keys to remove from internal/controller/size base profiles before comparison
+    # necessary because base profiles in internal/controller/size miss many keys that
     # OperandConfig can have
     local REMOVE_KEYS=".IBMLicenseServiceReporter?, .operandBindInfo?, .operandRequest?, \
         .datasource?, .mustgatherConfig?, .mustgatherService?, .navconfiguration?, \
@@ -62,12 +62,12 @@ cleanup() {
 
 trap 'cleanup' EXIT
 
-test_profile controllers/size/small_amd64.go
+test_profile internal/controller/size/small.go
 
 oc -n $NAMESPACE apply -f testdata/sizing/medium_size.yaml
 sleep 15
-test_profile controllers/size/medium_amd64.go
+test_profile internal/controller/size/medium.go
 
 oc -n $NAMESPACE apply -f testdata/sizing/large_size.yaml
 sleep 15
-test_profile controllers/size/large_amd64.go
+test_profile internal/controller/size/large.go